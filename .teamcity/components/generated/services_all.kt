@@ -126,6 +126,7 @@ val services = mapOf(
     "neptune" to ServiceSpec("Neptune"),
     "networkfirewall" to ServiceSpec("Network Firewall", vpcLock = true),
     "networkmanager" to ServiceSpec("Network Manager"),
+    "oam" to ServiceSpec("CloudWatch Observability Access Manager"),
     "opensearch" to ServiceSpec("OpenSearch"),
     "opensearchserverless" to ServiceSpec("OpenSearch Serverless"),
     "opsworks" to ServiceSpec("OpsWorks", vpcLock = true),
@@ -159,6 +160,7 @@ val services = mapOf(
     "schemas" to ServiceSpec("EventBridge Schemas"),
     "secretsmanager" to ServiceSpec("Secrets Manager"),
     "securityhub" to ServiceSpec("Security Hub"),
+    "securitylake" to ServiceSpec("Security Lake"),
     "serverlessrepo" to ServiceSpec("Serverless Application Repository"),
     "servicecatalog" to ServiceSpec("Service Catalog"),
     "servicediscovery" to ServiceSpec("Cloud Map", vpcLock = true),
@@ -180,6 +182,7 @@ val services = mapOf(
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
+    "vpclattice" to ServiceSpec("VPC Lattice"),
     "waf" to ServiceSpec("WAF Classic"),
     "wafregional" to ServiceSpec("WAF Classic Regional"),
     "wafv2" to ServiceSpec("WAF"),
@@ -1,11 +1,11 @@
 module github.com/hashicorp/terraform-provider-aws
 
-go 1.19
+go 1.20
 
 require (
 	github.com/ProtonMail/go-crypto v0.0.0-20230201104953-d1d05f4e2bfb
-	github.com/aws/aws-sdk-go v1.44.198
-	github.com/aws/aws-sdk-go-v2 v1.17.4
+	github.com/aws/aws-sdk-go v1.47.13
+	github.com/aws/aws-sdk-go-v2 v1.30.3
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.12.22
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.23.1
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.11.2
@@ -13,13 +13,14 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/comprehend v1.21.1
 	github.com/aws/aws-sdk-go-v2/service/computeoptimizer v1.21.0
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.84.1
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.31.0
 	github.com/aws/aws-sdk-go-v2/service/fis v1.14.1
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.16.1
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.11.2
 	github.com/aws/aws-sdk-go-v2/service/ivschat v1.3.1
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.38.2
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.29.1
-	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.1.2
+	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.8.0
 	github.com/aws/aws-sdk-go-v2/service/pipes v1.1.1
 	github.com/aws/aws-sdk-go-v2/service/rds v1.40.2
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.2.2
@@ -27,11 +28,14 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.14.1
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.29.2
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.1.1
+	github.com/aws/aws-sdk-go-v2/service/securityhub v1.42.1
+	github.com/aws/aws-sdk-go-v2/service/securitylake v1.4.0
 	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.16.1
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.35.2
 	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.20.1
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.25.1
-	github.com/aws/smithy-go v1.13.5
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.0.0
+	github.com/aws/smithy-go v1.20.3
 	github.com/beevik/etree v1.1.0
 	github.com/google/go-cmp v0.5.9
 	github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go v0.20.0
@@ -59,9 +63,9 @@ require (
 	github.com/mitchellh/go-testing-interface v1.14.1
 	github.com/pquerna/otp v1.4.0
 	github.com/shopspring/decimal v1.3.1
-	golang.org/x/crypto v0.6.0
+	golang.org/x/crypto v0.14.0
 	golang.org/x/exp v0.0.0-20230206171751-46f607a40771
-	golang.org/x/tools v0.2.0
+	golang.org/x/tools v0.6.0
 	gopkg.in/dnaeon/go-vcr.v3 v3.1.2
 	gopkg.in/yaml.v2 v2.4.0
 	syreclabs.com/go/faker v1.2.3
@@ -77,8 +81,8 @@ require (
 	github.com/armon/go-radix v0.0.0-20180808171621-7fddfc383310 // indirect
 	github.com/aws/aws-sdk-go-v2/config v1.18.12 // indirect
 	github.com/aws/aws-sdk-go-v2/credentials v1.13.12 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.28 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.22 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.15 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.15 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.29 // indirect
 	github.com/aws/aws-sdk-go-v2/service/iam v1.19.2 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.22 // indirect
@@ -127,9 +131,9 @@ require (
 	go.opentelemetry.io/otel v1.13.0 // indirect
 	go.opentelemetry.io/otel/trace v1.13.0 // indirect
 	golang.org/x/mod v0.8.0 // indirect
-	golang.org/x/net v0.6.0 // indirect
-	golang.org/x/sys v0.5.0 // indirect
-	golang.org/x/text v0.7.0 // indirect
+	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/sys v0.13.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
 	google.golang.org/genproto v0.0.0-20230202175211-008b39050e57 // indirect
 	google.golang.org/grpc v1.53.0 // indirect
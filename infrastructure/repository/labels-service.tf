@@ -211,6 +211,7 @@ variable "service_labels" {
     "networkfirewall",
     "networkmanager",
     "nimble",
+    "oam",
     "opensearch",
     "opensearchserverless",
     "opsworks",
@@ -266,6 +267,7 @@ variable "service_labels" {
     "schemas",
     "secretsmanager",
     "securityhub",
+    "securitylake",
     "serverlessrepo",
     "servicecatalog",
     "servicecatalogappregistry",
@@ -303,6 +305,7 @@ variable "service_labels" {
     "translate",
     "voiceid",
     "vpc",
+    "vpclattice",
     "vpnclient",
     "vpnsite",
     "waf",
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ecr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/fis"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
@@ -24,10 +25,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	s3control_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	securityhub_sdkv2 "github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
 	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -228,6 +232,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/oam"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
@@ -337,11 +342,13 @@ type AWSClient struct {
 
 	httpClient *http.Client
 
-	ec2Client       lazyClient[*ec2_sdkv2.Client]
-	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
-	rdsClient       lazyClient[*rds_sdkv2.Client]
-	s3controlClient lazyClient[*s3control_sdkv2.Client]
-	ssmClient       lazyClient[*ssm_sdkv2.Client]
+	ec2Client         lazyClient[*ec2_sdkv2.Client]
+	ecrClient         lazyClient[*ecr_sdkv2.Client]
+	logsClient        lazyClient[*cloudwatchlogs_sdkv2.Client]
+	rdsClient         lazyClient[*rds_sdkv2.Client]
+	s3controlClient   lazyClient[*s3control_sdkv2.Client]
+	ssmClient         lazyClient[*ssm_sdkv2.Client]
+	securityhubClient lazyClient[*securityhub_sdkv2.Client]
 
 	acmConn                          *acm.ACM
 	acmpcaConn                       *acmpca.ACMPCA
@@ -549,6 +556,7 @@ type AWSClient struct {
 	networkfirewallConn              *networkfirewall.NetworkFirewall
 	networkmanagerConn               *networkmanager.NetworkManager
 	nimbleConn                       *nimblestudio.NimbleStudio
+	oamConn                          *oam.OAM
 	opensearchConn                   *opensearchservice.OpenSearchService
 	opensearchserverlessClient       *opensearchserverless.Client
 	opsworksConn                     *opsworks.OpsWorks
@@ -618,6 +626,7 @@ type AWSClient struct {
 	schemasConn                      *schemas.Schemas
 	secretsmanagerConn               *secretsmanager.SecretsManager
 	securityhubConn                  *securityhub.SecurityHub
+	securitylakeClient               *securitylake.Client
 	serverlessrepoConn               *serverlessapplicationrepository.ServerlessApplicationRepository
 	servicecatalogConn               *servicecatalog.ServiceCatalog
 	servicecatalogappregistryConn    *appregistry.AppRegistry
@@ -638,6 +647,7 @@ type AWSClient struct {
 	transcribestreamingConn          *transcribestreamingservice.TranscribeStreamingService
 	transferConn                     *transfer.Transfer
 	translateConn                    *translate.Translate
+	vpclatticeClient                 *vpclattice.Client
 	voiceidConn                      *voiceid.VoiceID
 	wafConn                          *waf.WAF
 	wafregionalConn                  *wafregional.WAFRegional
@@ -1027,6 +1037,10 @@ func (client *AWSClient) ECRConn() *ecr.ECR {
 	return client.ecrConn
 }
 
+func (client *AWSClient) ECRClient() *ecr_sdkv2.Client {
+	return client.ecrClient.Client()
+}
+
 func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
 	return client.ecrpublicConn
 }
@@ -1487,6 +1501,10 @@ func (client *AWSClient) NimbleConn() *nimblestudio.NimbleStudio {
 	return client.nimbleConn
 }
 
+func (client *AWSClient) ObservabilityAccessManagerConn() *oam.OAM {
+	return client.oamConn
+}
+
 func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
 	return client.opensearchConn
 }
@@ -1775,6 +1793,14 @@ func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
 	return client.securityhubConn
 }
 
+func (client *AWSClient) SecurityHubClient() *securityhub_sdkv2.Client {
+	return client.securityhubClient.Client()
+}
+
+func (client *AWSClient) SecurityLakeClient() *securitylake.Client {
+	return client.securitylakeClient
+}
+
 func (client *AWSClient) ServerlessRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
 	return client.serverlessrepoConn
 }
@@ -1855,6 +1881,10 @@ func (client *AWSClient) TranslateConn() *translate.Translate {
 	return client.translateConn
 }
 
+func (client *AWSClient) VPCLatticeClient() *vpclattice.Client {
+	return client.vpclatticeClient
+}
+
 func (client *AWSClient) VoiceIDConn() *voiceid.VoiceID {
 	return client.voiceidConn
 }
@@ -571,6 +571,46 @@ func TestBoolFromFramework(t *testing.T) {
 	}
 }
 
+func TestInt32FromFramework(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    types.Int64
+		expected *int32
+	}
+	tests := map[string]testCase{
+		"valid int64": {
+			input:    types.Int64Value(42),
+			expected: aws.Int32(42),
+		},
+		"zero int64": {
+			input:    types.Int64Value(0),
+			expected: aws.Int32(0),
+		},
+		"null int64": {
+			input:    types.Int64Null(),
+			expected: nil,
+		},
+		"unknown int64": {
+			input:    types.Int64Unknown(),
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Int32FromFramework(context.Background(), test.input)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64FromFramework(t *testing.T) {
 	t.Parallel()
 
@@ -715,6 +755,42 @@ func TestBoolToFrameworkLegacy(t *testing.T) {
 	}
 }
 
+func TestInt32ToFramework(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    *int32
+		expected types.Int64
+	}
+	tests := map[string]testCase{
+		"valid int32": {
+			input:    aws.Int32(42),
+			expected: types.Int64Value(42),
+		},
+		"zero int32": {
+			input:    aws.Int32(0),
+			expected: types.Int64Value(0),
+		},
+		"nil int32": {
+			input:    nil,
+			expected: types.Int64Null(),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Int32ToFramework(context.Background(), test.input)
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestInt64ToFramework(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,51 @@
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// arnValidator validates that a string Attribute's value is a valid ARN.
+type arnValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator arnValidator) Description(_ context.Context) string {
+	return "value must be a valid ARN"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator arnValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator arnValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, errs := verify.ValidARN(request.ConfigValue.ValueString(), request.Path.String()); len(errs) > 0 {
+		for _, err := range errs {
+			response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+				request.Path,
+				validator.Description(ctx),
+				err.Error(),
+			))
+		}
+
+		return
+	}
+}
+
+// ARN returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid ARN.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ARN() validator.String {
+	return arnValidator{}
+}
@@ -610,9 +610,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
 			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
 
-			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
-			"aws_ecr_image":               ecr.DataSourceImage(),
-			"aws_ecr_repository":          ecr.DataSourceRepository(),
+			"aws_ecr_authorization_token":       ecr.DataSourceAuthorizationToken(),
+			"aws_ecr_image":                     ecr.DataSourceImage(),
+			"aws_ecr_lifecycle_policy_document": ecr.DataSourceLifecyclePolicyDocument(),
+			"aws_ecr_repository":                ecr.DataSourceRepository(),
 
 			"aws_ecrpublic_authorization_token": ecrpublic.DataSourceAuthorizationToken(),
 
@@ -893,8 +894,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_servicequotas_service":       servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
-			"aws_sfn_activity":      sfn.DataSourceActivity(),
-			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
+			"aws_sfn_activity":               sfn.DataSourceActivity(),
+			"aws_sfn_alias":                  sfn.DataSourceAlias(),
+			"aws_sfn_state_machine":          sfn.DataSourceStateMachine(),
+			"aws_sfn_state_machine_versions": sfn.DataSourceStateMachineVersions(),
 
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
 			"aws_signer_signing_profile": signer.DataSourceSigningProfile(),
@@ -1117,6 +1120,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_cloudformation_type":               cloudformation.ResourceType(),
 
 			"aws_cloudfront_cache_policy":                   cloudfront.ResourceCachePolicy(),
+			"aws_cloudfront_continuous_deployment_policy":   cloudfront.ResourceContinuousDeploymentPolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.ResourceDistribution(),
 			"aws_cloudfront_field_level_encryption_config":  cloudfront.ResourceFieldLevelEncryptionConfig(),
 			"aws_cloudfront_field_level_encryption_profile": cloudfront.ResourceFieldLevelEncryptionProfile(),
@@ -1149,6 +1153,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_cloudwatch_event_bus":             events.ResourceBus(),
 			"aws_cloudwatch_event_bus_policy":      events.ResourceBusPolicy(),
 			"aws_cloudwatch_event_connection":      events.ResourceConnection(),
+			"aws_cloudwatch_event_endpoint":        events.ResourceEndpoint(),
 			"aws_cloudwatch_event_permission":      events.ResourcePermission(),
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
@@ -1319,6 +1324,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_table_replica":                 dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
@@ -1447,6 +1453,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ecr_registry_scanning_configuration": ecr.ResourceRegistryScanningConfiguration(),
 			"aws_ecr_replication_configuration":       ecr.ResourceReplicationConfiguration(),
 			"aws_ecr_repository":                      ecr.ResourceRepository(),
+			"aws_ecr_repository_creation_template":    ecr.ResourceRepositoryCreationTemplate(),
 			"aws_ecr_repository_policy":               ecr.ResourceRepositoryPolicy(),
 
 			"aws_ecrpublic_repository":        ecrpublic.ResourceRepository(),
@@ -1574,6 +1581,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_glue_classifier":                       glue.ResourceClassifier(),
 			"aws_glue_connection":                       glue.ResourceConnection(),
 			"aws_glue_crawler":                          glue.ResourceCrawler(),
+			"aws_glue_data_quality_ruleset":             glue.ResourceDataQualityRuleset(),
 			"aws_glue_data_catalog_encryption_settings": glue.ResourceDataCatalogEncryptionSettings(),
 			"aws_glue_dev_endpoint":                     glue.ResourceDevEndpoint(),
 			"aws_glue_job":                              glue.ResourceJob(),
@@ -1594,42 +1602,50 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_grafana_workspace_api_key":            grafana.ResourceWorkspaceAPIKey(),
 			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSAMLConfiguration(),
 
-			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
-			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
-			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
-			"aws_guardduty_ipset":                      guardduty.ResourceIPSet(),
-			"aws_guardduty_member":                     guardduty.ResourceMember(),
-			"aws_guardduty_organization_admin_account": guardduty.ResourceOrganizationAdminAccount(),
-			"aws_guardduty_organization_configuration": guardduty.ResourceOrganizationConfiguration(),
-			"aws_guardduty_publishing_destination":     guardduty.ResourcePublishingDestination(),
-			"aws_guardduty_threatintelset":             guardduty.ResourceThreatIntelSet(),
-
-			"aws_iam_access_key":                  iam.ResourceAccessKey(),
-			"aws_iam_account_alias":               iam.ResourceAccountAlias(),
-			"aws_iam_account_password_policy":     iam.ResourceAccountPasswordPolicy(),
-			"aws_iam_group":                       iam.ResourceGroup(),
-			"aws_iam_group_membership":            iam.ResourceGroupMembership(),
-			"aws_iam_group_policy":                iam.ResourceGroupPolicy(),
-			"aws_iam_group_policy_attachment":     iam.ResourceGroupPolicyAttachment(),
-			"aws_iam_instance_profile":            iam.ResourceInstanceProfile(),
-			"aws_iam_openid_connect_provider":     iam.ResourceOpenIDConnectProvider(),
-			"aws_iam_policy":                      iam.ResourcePolicy(),
-			"aws_iam_policy_attachment":           iam.ResourcePolicyAttachment(),
-			"aws_iam_role":                        iam.ResourceRole(),
-			"aws_iam_role_policy":                 iam.ResourceRolePolicy(),
-			"aws_iam_role_policy_attachment":      iam.ResourceRolePolicyAttachment(),
-			"aws_iam_saml_provider":               iam.ResourceSAMLProvider(),
-			"aws_iam_server_certificate":          iam.ResourceServerCertificate(),
-			"aws_iam_service_linked_role":         iam.ResourceServiceLinkedRole(),
-			"aws_iam_service_specific_credential": iam.ResourceServiceSpecificCredential(),
-			"aws_iam_signing_certificate":         iam.ResourceSigningCertificate(),
-			"aws_iam_user":                        iam.ResourceUser(),
-			"aws_iam_user_group_membership":       iam.ResourceUserGroupMembership(),
-			"aws_iam_user_login_profile":          iam.ResourceUserLoginProfile(),
-			"aws_iam_user_policy":                 iam.ResourceUserPolicy(),
-			"aws_iam_user_policy_attachment":      iam.ResourceUserPolicyAttachment(),
-			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
-			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
+			"aws_guardduty_detector":                           guardduty.ResourceDetector(),
+			"aws_guardduty_detector_feature":                   guardduty.ResourceDetectorFeature(),
+			"aws_guardduty_filter":                             guardduty.ResourceFilter(),
+			"aws_guardduty_invite_accepter":                    guardduty.ResourceInviteAccepter(),
+			"aws_guardduty_ipset":                              guardduty.ResourceIPSet(),
+			"aws_guardduty_member":                             guardduty.ResourceMember(),
+			"aws_guardduty_organization_admin_account":         guardduty.ResourceOrganizationAdminAccount(),
+			"aws_guardduty_organization_configuration":         guardduty.ResourceOrganizationConfiguration(),
+			"aws_guardduty_organization_configuration_feature": guardduty.ResourceOrganizationConfigurationFeature(),
+			"aws_guardduty_publishing_destination":             guardduty.ResourcePublishingDestination(),
+			"aws_guardduty_threatintelset":                     guardduty.ResourceThreatIntelSet(),
+
+			"aws_iam_access_key":                         iam.ResourceAccessKey(),
+			"aws_iam_account_alias":                      iam.ResourceAccountAlias(),
+			"aws_iam_account_password_policy":            iam.ResourceAccountPasswordPolicy(),
+			"aws_iam_group":                              iam.ResourceGroup(),
+			"aws_iam_group_membership":                   iam.ResourceGroupMembership(),
+			"aws_iam_group_policies_exclusive":           iam.ResourceGroupPoliciesExclusive(),
+			"aws_iam_group_policy":                       iam.ResourceGroupPolicy(),
+			"aws_iam_group_policy_attachment":            iam.ResourceGroupPolicyAttachment(),
+			"aws_iam_group_policy_attachments_exclusive": iam.ResourceGroupPolicyAttachmentsExclusive(),
+			"aws_iam_instance_profile":                   iam.ResourceInstanceProfile(),
+			"aws_iam_openid_connect_provider":            iam.ResourceOpenIDConnectProvider(),
+			"aws_iam_policy":                             iam.ResourcePolicy(),
+			"aws_iam_policy_attachment":                  iam.ResourcePolicyAttachment(),
+			"aws_iam_role":                               iam.ResourceRole(),
+			"aws_iam_role_policies_exclusive":            iam.ResourceRolePoliciesExclusive(),
+			"aws_iam_role_policy":                        iam.ResourceRolePolicy(),
+			"aws_iam_role_policy_attachment":             iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":  iam.ResourceRolePolicyAttachmentsExclusive(),
+			"aws_iam_saml_provider":                      iam.ResourceSAMLProvider(),
+			"aws_iam_server_certificate":                 iam.ResourceServerCertificate(),
+			"aws_iam_service_linked_role":                iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":        iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                iam.ResourceSigningCertificate(),
+			"aws_iam_user":                               iam.ResourceUser(),
+			"aws_iam_user_group_membership":              iam.ResourceUserGroupMembership(),
+			"aws_iam_user_login_profile":                 iam.ResourceUserLoginProfile(),
+			"aws_iam_user_policies_exclusive":            iam.ResourceUserPoliciesExclusive(),
+			"aws_iam_user_policy":                        iam.ResourceUserPolicy(),
+			"aws_iam_user_policy_attachment":             iam.ResourceUserPolicyAttachment(),
+			"aws_iam_user_policy_attachments_exclusive":  iam.ResourceUserPolicyAttachmentsExclusive(),
+			"aws_iam_user_ssh_key":                       iam.ResourceUserSSHKey(),
+			"aws_iam_virtual_mfa_device":                 iam.ResourceVirtualMFADevice(),
 
 			"aws_identitystore_group":            identitystore.ResourceGroup(),
 			"aws_identitystore_user":             identitystore.ResourceUser(),
@@ -1711,8 +1727,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
+			"aws_lakeformation_data_cells_filter":  lakeformation.ResourceDataCellsFilter(),
 			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
 			"aws_lakeformation_lf_tag":             lakeformation.ResourceLFTag(),
+			"aws_lakeformation_opt_in":             lakeformation.ResourceOptIn(),
 			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
 			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
 			"aws_lakeformation_resource_lf_tags":   lakeformation.ResourceResourceLFTags(),
@@ -1728,6 +1746,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
 			"aws_lambda_provisioned_concurrency_config": lambda.ResourceProvisionedConcurrencyConfig(),
+			"aws_lambda_runtime_management_config":      lambda.ResourceRuntimeManagementConfig(),
 
 			"aws_lex_bot":       lexmodels.ResourceBot(),
 			"aws_lex_bot_alias": lexmodels.ResourceBotAlias(),
@@ -1882,9 +1901,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_qldb_ledger": qldb.ResourceLedger(),
 			"aws_qldb_stream": qldb.ResourceStream(),
 
+			"aws_quicksight_analysis":         quicksight.ResourceAnalysis(),
+			"aws_quicksight_dashboard":        quicksight.ResourceDashboard(),
+			"aws_quicksight_data_set":         quicksight.ResourceDataSet(),
 			"aws_quicksight_data_source":      quicksight.ResourceDataSource(),
 			"aws_quicksight_group":            quicksight.ResourceGroup(),
 			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
+			"aws_quicksight_refresh_schedule": quicksight.ResourceRefreshSchedule(),
+			"aws_quicksight_template":         quicksight.ResourceTemplate(),
 			"aws_quicksight_user":             quicksight.ResourceUser(),
 
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
@@ -1948,6 +1972,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_rolesanywhere_profile":      rolesanywhere.ResourceProfile(),
 			"aws_rolesanywhere_trust_anchor": rolesanywhere.ResourceTrustAnchor(),
 
+			"aws_route53_cidr_collection":               route53.ResourceCIDRCollection(),
+			"aws_route53_cidr_location":                 route53.ResourceCIDRLocation(),
 			"aws_route53_delegation_set":                route53.ResourceDelegationSet(),
 			"aws_route53_health_check":                  route53.ResourceHealthCheck(),
 			"aws_route53_hosted_zone_dnssec":            route53.ResourceHostedZoneDNSSEC(),
@@ -2005,6 +2031,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -2029,6 +2056,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
 			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
 			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
+			"aws_sagemaker_pipeline":                                  sagemaker.ResourcePipeline(),
 			"aws_sagemaker_project":                                   sagemaker.ResourceProject(),
 			"aws_sagemaker_space":                                     sagemaker.ResourceSpace(),
 			"aws_sagemaker_servicecatalog_portfolio_status":           sagemaker.ResourceServicecatalogPortfolioStatus(),
@@ -2051,6 +2079,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_securityhub_account":                    securityhub.ResourceAccount(),
 			"aws_securityhub_action_target":              securityhub.ResourceActionTarget(),
+			"aws_securityhub_automation_rule":            securityhub.ResourceAutomationRule(),
+			"aws_securityhub_configuration_policy":       securityhub.ResourceConfigurationPolicy(),
 			"aws_securityhub_insight":                    securityhub.ResourceInsight(),
 			"aws_securityhub_invite_accepter":            securityhub.ResourceInviteAccepter(),
 			"aws_securityhub_member":                     securityhub.ResourceMember(),
@@ -2102,6 +2132,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_sesv2_configuration_set":                   sesv2.ResourceConfigurationSet(),
 			"aws_sesv2_configuration_set_event_destination": sesv2.ResourceConfigurationSetEventDestination(),
+			"aws_sesv2_contact_list":                        sesv2.ResourceContactList(),
 			"aws_sesv2_dedicated_ip_assignment":             sesv2.ResourceDedicatedIPAssignment(),
 			"aws_sesv2_dedicated_ip_pool":                   sesv2.ResourceDedicatedIPPool(),
 			"aws_sesv2_email_identity":                      sesv2.ResourceEmailIdentity(),
@@ -2109,6 +2140,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_sesv2_email_identity_mail_from_attributes": sesv2.ResourceEmailIdentityMailFromAttributes(),
 
 			"aws_sfn_activity":      sfn.ResourceActivity(),
+			"aws_sfn_alias":         sfn.ResourceAlias(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
 			"aws_shield_protection":                          shield.ResourceProtection(),
@@ -2174,12 +2206,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_transcribe_vocabulary":         transcribe.ResourceVocabulary(),
 			"aws_transcribe_vocabulary_filter":  transcribe.ResourceVocabularyFilter(),
 
-			"aws_transfer_access":   transfer.ResourceAccess(),
-			"aws_transfer_server":   transfer.ResourceServer(),
-			"aws_transfer_ssh_key":  transfer.ResourceSSHKey(),
-			"aws_transfer_tag":      transfer.ResourceTag(),
-			"aws_transfer_user":     transfer.ResourceUser(),
-			"aws_transfer_workflow": transfer.ResourceWorkflow(),
+			"aws_transfer_access":      transfer.ResourceAccess(),
+			"aws_transfer_agreement":   transfer.ResourceAgreement(),
+			"aws_transfer_certificate": transfer.ResourceCertificate(),
+			"aws_transfer_connector":   transfer.ResourceConnector(),
+			"aws_transfer_profile":     transfer.ResourceProfile(),
+			"aws_transfer_server":      transfer.ResourceServer(),
+			"aws_transfer_ssh_key":     transfer.ResourceSSHKey(),
+			"aws_transfer_tag":         transfer.ResourceTag(),
+			"aws_transfer_user":        transfer.ResourceUser(),
+			"aws_transfer_workflow":    transfer.ResourceWorkflow(),
 
 			"aws_waf_byte_match_set":          waf.ResourceByteMatchSet(),
 			"aws_waf_geo_match_set":           waf.ResourceGeoMatchSet(),
@@ -134,12 +134,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/oam"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearchserverless"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/pipes"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/pricing"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -166,6 +168,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/securitylake"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessrepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
@@ -187,6 +190,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/timestreamwrite"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transcribe"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafregional"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wafv2"
@@ -326,12 +330,14 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		neptune.ServicePackage,
 		networkfirewall.ServicePackage,
 		networkmanager.ServicePackage,
+		oam.ServicePackage,
 		opensearch.ServicePackage,
 		opensearchserverless.ServicePackage,
 		opsworks.ServicePackage,
 		organizations.ServicePackage,
 		outposts.ServicePackage,
 		pinpoint.ServicePackage,
+		pipes.ServicePackage,
 		pricing.ServicePackage,
 		qldb.ServicePackage,
 		quicksight.ServicePackage,
@@ -358,6 +364,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		schemas.ServicePackage,
 		secretsmanager.ServicePackage,
 		securityhub.ServicePackage,
+		securitylake.ServicePackage,
 		serverlessrepo.ServicePackage,
 		servicecatalog.ServicePackage,
 		servicediscovery.ServicePackage,
@@ -379,6 +386,7 @@ func servicePackages(context.Context) []intf.ServicePackage {
 		timestreamwrite.ServicePackage,
 		transcribe.ServicePackage,
 		transfer.ServicePackage,
+		vpclattice.ServicePackage,
 		waf.ServicePackage,
 		wafregional.ServicePackage,
 		wafv2.ServicePackage,
@@ -3,9 +3,10 @@ package cloudfront
 const (
 	StreamTypeKinesis = "Kinesis"
 
-	ResNameDistribution         = "Distribution"
-	ResNamePublicKey            = "Public Key"
-	ResNameOriginAccessIdentity = "Origin Access Identity"
+	ResNameContinuousDeploymentPolicy = "Continuous Deployment Policy"
+	ResNameDistribution               = "Distribution"
+	ResNamePublicKey                  = "Public Key"
+	ResNameOriginAccessIdentity       = "Origin Access Identity"
 )
 
 func StreamType_Values() []string {
@@ -2,6 +2,7 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -230,6 +232,10 @@ func ResourceDistribution() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			"continuous_deployment_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -645,6 +651,19 @@ func ResourceDistribution() *schema.Resource {
 				Default:      cloudfront.PriceClassPriceClassAll,
 				ValidateFunc: validation.StringInSlice(cloudfront.PriceClass_Values(), false),
 			},
+			// promote_staging_distribution_id is a non-API attribute. When it is
+			// set or changed, the staging distribution's configuration is copied
+			// to this distribution once.
+			"promote_staging_distribution_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// promoted_staging_distribution_etag is a non-API attribute that
+			// records the staging distribution ETag promoted.
+			"promoted_staging_distribution_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"restrictions": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -707,6 +726,12 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			"staging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 			"web_acl_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -823,7 +848,10 @@ func ResourceDistribution() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffPromoteStagingDistribution,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -950,6 +978,45 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
+	// Promotion replaces this distribution's configuration with the staging
+	// distribution's, so only promote when promote_staging_distribution_id is
+	// set or changed. This resource's configuration is then applied on top of
+	// the promoted configuration, so other changes in the same apply are not
+	// postponed.
+	if stagingID := d.Get("promote_staging_distribution_id").(string); stagingID != "" && d.HasChange("promote_staging_distribution_id") {
+		etag, stagingETag, err := promoteStagingDistribution(ctx, conn, d.Id(), stagingID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "promoting CloudFront Distribution (%s) configuration to CloudFront Distribution (%s): %s", stagingID, d.Id(), err)
+		}
+
+		d.Set("etag", etag)
+		d.Set("promoted_staging_distribution_etag", stagingETag)
+	}
+
+	diags = append(diags, updateDistributionConfig(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDistributionRead(ctx, d, meta)...)
+}
+
+// updateDistributionConfig applies this resource's configuration to the
+// distribution and, if configured, waits for it to be deployed.
+func updateDistributionConfig(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
+
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -1012,14 +1079,7 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags_all") {
-		o, n := d.GetChange("tags_all")
-		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating tags for CloudFront Distribution (%s): %s", d.Id(), err)
-		}
-	}
-
-	return append(diags, resourceDistributionRead(ctx, d, meta)...)
+	return diags
 }
 
 func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1189,6 +1249,54 @@ func resourceDistributionDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+// promoteStagingDistribution copies the configuration of a staging distribution
+// to its primary distribution and returns the primary distribution's new ETag
+// and the staging distribution ETag that was promoted. Both distributions must be deployed, and the request must
+// carry the current ETag of each.
+func promoteStagingDistribution(ctx context.Context, conn *cloudfront.CloudFront, primaryID, stagingID string) (string, string, error) {
+	primary, err := FindDistributionByID(ctx, conn, primaryID)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading primary distribution: %w", err)
+	}
+
+	staging, err := FindDistributionByID(ctx, conn, stagingID)
+
+	if err != nil {
+		return "", "", fmt.Errorf("reading staging distribution: %w", err)
+	}
+
+	input := &cloudfront.UpdateDistributionWithStagingConfigInput{
+		Id:                    aws.String(primaryID),
+		IfMatch:               aws.String(fmt.Sprintf("%s, %s", aws.StringValue(primary.ETag), aws.StringValue(staging.ETag))),
+		StagingDistributionId: aws.String(stagingID),
+	}
+
+	log.Printf("[DEBUG] Promoting CloudFront Distribution (%s) configuration to CloudFront Distribution (%s)", stagingID, primaryID)
+	output, err := conn.UpdateDistributionWithStagingConfigWithContext(ctx, input)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return aws.StringValue(output.ETag), aws.StringValue(staging.ETag), nil
+}
+
+// customizeDiffPromoteStagingDistribution plans a promotion when
+// promote_staging_distribution_id is set or changed. The promoted ETag is
+// only known once the promotion has been applied.
+func customizeDiffPromoteStagingDistribution(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("promote_staging_distribution_id") {
+		return nil
+	}
+
+	if diff.NewValueKnown("promote_staging_distribution_id") && diff.Get("promote_staging_distribution_id").(string) == "" {
+		return diff.SetNew("promoted_staging_distribution_etag", "")
+	}
+
+	return diff.SetNewComputed("promoted_staging_distribution_etag")
+}
+
 // resourceAwsCloudFrontWebDistributionWaitUntilDeployed blocks until the
 // distribution is deployed. It currently takes exactly 15 minutes to deploy
 // but that might change in the future.
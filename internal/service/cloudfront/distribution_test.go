@@ -1420,6 +1420,88 @@ func TestAccCloudFrontDistribution_preconditionFailed(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistribution_continuousDeployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var primary, staging cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	stagingResourceName := "aws_cloudfront_distribution.staging"
+	policyResourceName := "aws_cloudfront_continuous_deployment_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_continuousDeployment("primary", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &primary),
+					testAccCheckDistributionExists(ctx, stagingResourceName, &staging),
+					resource.TestCheckResourceAttr(resourceName, "comment", "primary"),
+					resource.TestCheckResourceAttr(resourceName, "continuous_deployment_policy_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "staging", "false"),
+					resource.TestCheckResourceAttr(stagingResourceName, "staging", "true"),
+					resource.TestCheckResourceAttr(stagingResourceName, "status", "Deployed"),
+				),
+			},
+			{
+				Config: testAccDistributionConfig_continuousDeployment("primary", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &primary),
+					resource.TestCheckResourceAttrPair(resourceName, "continuous_deployment_policy_id", policyResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Deployed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_on_delete",
+					"wait_for_deployment",
+				},
+			},
+			{
+				// Changes made in the same apply as the promotion are applied on top of the promoted configuration.
+				Config: testAccDistributionConfig_continuousDeployment("promoted", true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &primary),
+					resource.TestCheckResourceAttr(resourceName, "comment", "promoted"),
+					resource.TestCheckResourceAttrPair(resourceName, "continuous_deployment_policy_id", policyResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "promote_staging_distribution_id", stagingResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "promoted_staging_distribution_etag", stagingResourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "staging", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Deployed"),
+				),
+			},
+			{
+				Config:   testAccDistributionConfig_continuousDeployment("promoted", true, true),
+				PlanOnly: true,
+			},
+			{
+				// Differences from the promoted configuration are not hidden.
+				Config:             testAccDistributionConfig_continuousDeployment("primary", true, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDistributionConfig_continuousDeployment("updated", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &primary),
+					resource.TestCheckResourceAttr(resourceName, "comment", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "promote_staging_distribution_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "promoted_staging_distribution_etag", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDistributionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontConn()
@@ -4472,3 +4554,84 @@ resource "aws_cloudfront_distribution" "test" {
 }
 `, rName, testAccDistributionRetainConfig(), which))
 }
+
+func testAccDistributionConfig_continuousDeploymentDistribution(name, comment string, staging bool, extra string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_distribution" %[1]q {
+  comment = %[2]q
+  enabled = true
+  staging = %[3]t
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "all"
+      }
+    }
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+
+%[4]s
+}
+`, name, comment, staging, extra)
+}
+
+func testAccDistributionConfig_continuousDeployment(primaryComment string, attach, promote bool) string {
+	var extra string
+	if attach {
+		extra = "  continuous_deployment_policy_id = aws_cloudfront_continuous_deployment_policy.test.id"
+	}
+	if promote {
+		extra += "\n  promote_staging_distribution_id = aws_cloudfront_distribution.staging.id"
+	}
+
+	return acctest.ConfigCompose(
+		testAccDistributionConfig_continuousDeploymentDistribution("staging", "staging", true, ""),
+		testAccDistributionConfig_continuousDeploymentDistribution("test", primaryComment, false, extra),
+		`
+resource "aws_cloudfront_continuous_deployment_policy" "test" {
+  enabled = true
+
+  staging_distribution_dns_names {
+    items = [aws_cloudfront_distribution.staging.domain_name]
+  }
+
+  traffic_config {
+    type = "SingleHeader"
+
+    single_header_config {
+      header = "aws-cf-cd-test"
+      value  = "test"
+    }
+  }
+}
+`)
+}
@@ -127,3 +127,20 @@ func ListOriginAccessControlsPages(ctx context.Context, conn *cloudfront.CloudFr
 	}
 	return nil
 }
+
+func ListContinuousDeploymentPoliciesPages(ctx context.Context, conn *cloudfront.CloudFront, input *cloudfront.ListContinuousDeploymentPoliciesInput, fn func(*cloudfront.ListContinuousDeploymentPoliciesOutput, bool) bool) error {
+	for {
+		output, err := conn.ListContinuousDeploymentPoliciesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.ContinuousDeploymentPolicyList.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.ContinuousDeploymentPolicyList.NextMarker
+	}
+	return nil
+}
@@ -25,6 +25,14 @@ func init() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_cloudfront_continuous_deployment_policy", &resource.Sweeper{
+		Name: "aws_cloudfront_continuous_deployment_policy",
+		F:    sweepContinuousDeploymentPolicies,
+		Dependencies: []string{
+			"aws_cloudfront_distribution",
+		},
+	})
+
 	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
@@ -148,6 +156,64 @@ func sweepCachePolicies(region string) error {
 	return nil
 }
 
+func sweepContinuousDeploymentPolicies(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).CloudFrontConn()
+	input := &cloudfront.ListContinuousDeploymentPoliciesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = ListContinuousDeploymentPoliciesPages(ctx, conn, input, func(page *cloudfront.ListContinuousDeploymentPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ContinuousDeploymentPolicyList.Items {
+			id := aws.StringValue(v.ContinuousDeploymentPolicy.Id)
+
+			output, err := FindContinuousDeploymentPolicyByID(ctx, conn, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+
+			r := ResourceContinuousDeploymentPolicy()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("etag", output.ETag)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping CloudFront Continuous Deployment Policy sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudFront Continuous Deployment Policies (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudFront Continuous Deployment Policies (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDistributions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
@@ -189,6 +189,86 @@ func ResourceTable() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"import_table": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"local_secondary_index", "restore_source_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_compression_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputCompressionType_Values(), false),
+						},
+						"input_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(dynamodb.InputFormat_Values(), false),
+						},
+						"input_format_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"csv": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delimiter": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"header_list": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"s3_bucket_source": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bucket_owner": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"local_secondary_index": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -475,6 +555,76 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		if err != nil {
 			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
 		}
+	} else if v, ok := d.GetOk("import_table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandImportTable(v.([]interface{})[0].(map[string]interface{}))
+
+		tcp := &dynamodb.TableCreationParameters{
+			BillingMode: aws.String(d.Get("billing_mode").(string)),
+			KeySchema:   expandKeySchema(keySchemaMap),
+			TableName:   aws.String(tableName),
+		}
+
+		billingMode := d.Get("billing_mode").(string)
+
+		capacityMap := map[string]interface{}{
+			"write_capacity": d.Get("write_capacity"),
+			"read_capacity":  d.Get("read_capacity"),
+		}
+
+		tcp.ProvisionedThroughput = expandProvisionedThroughput(capacityMap, billingMode)
+
+		if v, ok := d.GetOk("attribute"); ok {
+			aSet := v.(*schema.Set)
+			tcp.AttributeDefinitions = expandAttributes(aSet.List())
+		}
+
+		if v, ok := d.GetOk("global_secondary_index"); ok {
+			globalSecondaryIndexes := []*dynamodb.GlobalSecondaryIndex{}
+			gsiSet := v.(*schema.Set)
+
+			for _, gsiObject := range gsiSet.List() {
+				gsi := gsiObject.(map[string]interface{})
+				if err := validateGSIProvisionedThroughput(gsi, billingMode); err != nil {
+					return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+				}
+
+				gsiObject := expandGlobalSecondaryIndex(gsi, billingMode)
+				globalSecondaryIndexes = append(globalSecondaryIndexes, gsiObject)
+			}
+			tcp.GlobalSecondaryIndexes = globalSecondaryIndexes
+		}
+
+		if v, ok := d.GetOk("server_side_encryption"); ok {
+			tcp.SSESpecification = expandEncryptAtRestOptions(v.([]interface{}))
+		}
+
+		input.ClientToken = aws.String(resource.UniqueId())
+		input.TableCreationParameters = tcp
+
+		importTableOutput, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
+			return conn.ImportTableWithContext(ctx, input)
+		}, func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, "ThrottlingException") {
+				return true, err
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "can be created, updated, or deleted simultaneously") {
+				return true, err
+			}
+			if tfawserr.ErrMessageContains(err, dynamodb.ErrCodeLimitExceededException, "indexed tables that can be created simultaneously") {
+				return true, err
+			}
+
+			return false, err
+		})
+
+		if err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, err)
+		}
+
+		importArn := aws.StringValue(importTableOutput.(*dynamodb.ImportTableOutput).ImportTableDescription.ImportArn)
+		if _, err := waitImportComplete(ctx, conn, importArn, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, tableName, fmt.Errorf("importing table (%s): %w", importArn, err))
+		}
 	} else {
 		input := &dynamodb.CreateTableInput{
 			BillingMode: aws.String(d.Get("billing_mode").(string)),
@@ -565,6 +715,43 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), err)
 	}
 
+	// ImportTable doesn't accept tags, stream or table class settings, so apply them to the imported table.
+	if _, ok := d.GetOk("import_table"); ok {
+		if len(tags) > 0 {
+			if err := UpdateTags(ctx, conn, aws.StringValue(output.TableArn), nil, tags); err != nil {
+				return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), fmt.Errorf("adding tags: %w", err))
+			}
+		}
+
+		input := &dynamodb.UpdateTableInput{
+			TableName: aws.String(d.Id()),
+		}
+		update := false
+
+		if v, ok := d.GetOk("stream_enabled"); ok {
+			input.StreamSpecification = &dynamodb.StreamSpecification{
+				StreamEnabled:  aws.Bool(v.(bool)),
+				StreamViewType: aws.String(d.Get("stream_view_type").(string)),
+			}
+			update = true
+		}
+
+		if v, ok := d.GetOk("table_class"); ok {
+			input.TableClass = aws.String(v.(string))
+			update = true
+		}
+
+		if update {
+			if _, err := conn.UpdateTableWithContext(ctx, input); err != nil {
+				return create.DiagError(names.DynamoDB, create.ErrActionCreating, ResNameTable, d.Id(), err)
+			}
+
+			if output, err = waitTableActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.DiagError(names.DynamoDB, create.ErrActionWaitingForCreation, ResNameTable, d.Id(), err)
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("global_secondary_index"); ok {
 		gsiSet := v.(*schema.Set)
 
@@ -2089,6 +2276,65 @@ func expandKeySchema(data map[string]interface{}) []*dynamodb.KeySchemaElement {
 	return keySchema
 }
 
+func expandImportTable(data map[string]interface{}) *dynamodb.ImportTableInput {
+	a := &dynamodb.ImportTableInput{}
+
+	if v, ok := data["input_compression_type"].(string); ok && v != "" {
+		a.InputCompressionType = aws.String(v)
+	}
+
+	if v, ok := data["input_format"].(string); ok && v != "" {
+		a.InputFormat = aws.String(v)
+	}
+
+	if v, ok := data["input_format_options"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.InputFormatOptions = expandInputFormatOptions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := data["s3_bucket_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		a.S3BucketSource = expandS3BucketSource(v[0].(map[string]interface{}))
+	}
+
+	return a
+}
+
+func expandInputFormatOptions(data map[string]interface{}) *dynamodb.InputFormatOptions {
+	a := &dynamodb.InputFormatOptions{}
+
+	if v, ok := data["csv"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		csv := v[0].(map[string]interface{})
+		a.Csv = &dynamodb.CsvOptions{}
+
+		if v, ok := csv["delimiter"].(string); ok && v != "" {
+			a.Csv.Delimiter = aws.String(v)
+		}
+
+		if v, ok := csv["header_list"].([]interface{}); ok && len(v) > 0 {
+			a.Csv.HeaderList = flex.ExpandStringList(v)
+		}
+	}
+
+	return a
+}
+
+func expandS3BucketSource(data map[string]interface{}) *dynamodb.S3BucketSource {
+	a := &dynamodb.S3BucketSource{}
+
+	if v, ok := data["bucket"].(string); ok && v != "" {
+		a.S3Bucket = aws.String(v)
+	}
+
+	if v, ok := data["bucket_owner"].(string); ok && v != "" {
+		a.S3BucketOwner = aws.String(v)
+	}
+
+	if v, ok := data["key_prefix"].(string); ok && v != "" {
+		a.S3KeyPrefix = aws.String(v)
+	}
+
+	return a
+}
+
 func expandEncryptAtRestOptions(vOptions []interface{}) *dynamodb.SSESpecification {
 	options := &dynamodb.SSESpecification{}
 
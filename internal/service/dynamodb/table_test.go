@@ -2376,6 +2376,70 @@ func TestAccDynamoDBTable_backup_overrideEncryption(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_importTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf dynamodb.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_importTable(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "billing_mode", "PAY_PER_REQUEST"),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "TestTableHashKey"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.header_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.header_list.0", "TestTableHashKey"),
+					resource.TestCheckResourceAttr(resourceName, "import_table.0.input_format_options.0.csv.0.header_list.1", "Value"),
+					resource.TestCheckResourceAttrPair(resourceName, "import_table.0.s3_bucket_source.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"import_table",
+				},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_importTableLocalSecondaryIndex(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_importTableLocalSecondaryIndex(rName),
+				ExpectError: regexp.MustCompile(`"import_table": conflicts with local_secondary_index`),
+			},
+		},
+	})
+}
+
 func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
@@ -4188,3 +4252,96 @@ resource "aws_dynamodb_table" "test" {
 }
 `, rName)
 }
+
+func testAccTableConfig_importTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/%[1]s.csv"
+  content = <<EOF
+TestTableHashKey,Value
+key1,value1
+key2,value2
+EOF
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  import_table {
+    input_compression_type = "NONE"
+    input_format           = "CSV"
+
+    input_format_options {
+      csv {
+        delimiter   = ","
+        header_list = ["TestTableHashKey", "Value"]
+      }
+    }
+
+    s3_bucket_source {
+      bucket     = aws_s3_bucket.test.bucket
+      key_prefix = "data/"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName)
+}
+
+func testAccTableConfig_importTableLocalSecondaryIndex(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+  range_key    = "TestTableRangeKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestTableRangeKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "TestLSIRangeKey"
+    type = "N"
+  }
+
+  local_secondary_index {
+    name            = "TestTableLSI"
+    range_key       = "TestLSIRangeKey"
+    projection_type = "ALL"
+  }
+
+  import_table {
+    input_format = "CSV"
+
+    s3_bucket_source {
+      bucket = %[1]q
+    }
+  }
+}
+`, rName)
+}
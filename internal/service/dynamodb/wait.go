@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -277,3 +279,50 @@ func waitContributorInsightsDeleted(ctx context.Context, conn *dynamodb.DynamoDB
 
 	return err
 }
+
+func waitTableExportCreated(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ExportDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.ExportStatusInProgress},
+		Target:     []string{dynamodb.ExportStatusCompleted},
+		Timeout:    timeout,
+		Refresh:    statusTableExport(ctx, conn, arn),
+		MinTimeout: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ExportDescription); ok {
+		if aws.StringValue(output.ExportStatus) == dynamodb.ExportStatusFailed {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImportComplete(ctx context.Context, conn *dynamodb.DynamoDB, arn string, timeout time.Duration) (*dynamodb.ImportTableDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{dynamodb.ImportStatusInProgress, dynamodb.ImportStatusCancelling},
+		Target:     []string{dynamodb.ImportStatusCompleted, dynamodb.ImportStatusCancelled, dynamodb.ImportStatusFailed},
+		Timeout:    maxDuration(createTableTimeout, timeout),
+		Refresh:    statusImport(ctx, conn, arn),
+		MinTimeout: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*dynamodb.ImportTableDescription); ok {
+		switch status := aws.StringValue(output.ImportStatus); status {
+		case dynamodb.ImportStatusCancelled:
+			return output, fmt.Errorf("import cancelled")
+		case dynamodb.ImportStatusFailed:
+			return output, fmt.Errorf("import failed: %s: %s", aws.StringValue(output.FailureCode), aws.StringValue(output.FailureMessage))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
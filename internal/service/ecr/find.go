@@ -3,10 +3,14 @@ package ecr
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ecr_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -38,3 +42,39 @@ func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.E
 
 	return output.PullThroughCacheRules[0], nil
 }
+
+// FindRepositoryCreationTemplateByPrefix returns the repository creation template for prefix and the ID of the registry it belongs to.
+func FindRepositoryCreationTemplateByPrefix(ctx context.Context, conn *ecr_sdkv2.Client, prefix string) (*types.RepositoryCreationTemplate, string, error) {
+	input := &ecr_sdkv2.DescribeRepositoryCreationTemplatesInput{
+		Prefixes: []string{prefix},
+	}
+
+	output, err := conn.DescribeRepositoryCreationTemplates(ctx, input)
+
+	if errs.IsA[*types.TemplateNotFoundException](err) {
+		return nil, "", &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if output == nil || len(output.RepositoryCreationTemplates) == 0 {
+		return nil, "", tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RepositoryCreationTemplates); count > 1 {
+		return nil, "", tfresource.NewTooManyResultsError(count, input)
+	}
+
+	template := output.RepositoryCreationTemplates[0]
+
+	if aws_sdkv2.ToString(template.Prefix) != prefix {
+		return nil, "", tfresource.NewEmptyResultError(input)
+	}
+
+	return &template, aws_sdkv2.ToString(output.RegistryId), nil
+}
@@ -184,11 +184,12 @@ func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData,
 }
 
 type lifecyclePolicyRuleSelection struct {
-	TagStatus     *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
-	TagPrefixList []*string `locationName:"tagPrefixList" type:"list"`
-	CountType     *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
-	CountUnit     *string   `locationName:"countUnit" type:"string" enum:"countType"`
-	CountNumber   *int64    `locationName:"countNumber" min:"1" type:"integer"`
+	TagStatus      *string   `locationName:"tagStatus" type:"string" enum:"tagStatus" required:"true"`
+	TagPatternList []*string `locationName:"tagPatternList" type:"list"`
+	TagPrefixList  []*string `locationName:"tagPrefixList" type:"list"`
+	CountType      *string   `locationName:"countType" type:"string" enum:"countType" required:"true"`
+	CountUnit      *string   `locationName:"countUnit" type:"string" enum:"countType"`
+	CountNumber    *int64    `locationName:"countNumber" min:"1" type:"integer"`
 }
 
 type lifecyclePolicyRuleAction struct {
@@ -217,6 +218,14 @@ func (lp *lifecyclePolicy) reduce() {
 }
 
 func (lprs *lifecyclePolicyRuleSelection) reduce() {
+	sort.Slice(lprs.TagPatternList, func(i, j int) bool {
+		return aws.StringValue(lprs.TagPatternList[i]) < aws.StringValue(lprs.TagPatternList[j])
+	})
+
+	if len(lprs.TagPatternList) == 0 {
+		lprs.TagPatternList = nil
+	}
+
 	sort.Slice(lprs.TagPrefixList, func(i, j int) bool {
 		return aws.StringValue(lprs.TagPrefixList[i]) < aws.StringValue(lprs.TagPrefixList[j])
 	})
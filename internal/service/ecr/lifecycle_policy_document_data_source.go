@@ -0,0 +1,295 @@
+package ecr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	lifecyclePolicyActionTypeExpire = "expire"
+
+	lifecyclePolicyCountTypeImageCountMoreThan = "imageCountMoreThan"
+	lifecyclePolicyCountTypeSinceImagePushed   = "sinceImagePushed"
+
+	lifecyclePolicyCountUnitDays = "days"
+
+	lifecyclePolicyTagStatusAny      = "any"
+	lifecyclePolicyTagStatusTagged   = "tagged"
+	lifecyclePolicyTagStatusUntagged = "untagged"
+)
+
+func lifecyclePolicyCountType_Values() []string {
+	return []string{
+		lifecyclePolicyCountTypeImageCountMoreThan,
+		lifecyclePolicyCountTypeSinceImagePushed,
+	}
+}
+
+func lifecyclePolicyTagStatus_Values() []string {
+	return []string{
+		lifecyclePolicyTagStatusAny,
+		lifecyclePolicyTagStatusTagged,
+		lifecyclePolicyTagStatusUntagged,
+	}
+}
+
+func DataSourceLifecyclePolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLifecyclePolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyActionTypeExpire}, false),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"selection": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count_number": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"count_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyCountType_Values(), false),
+									},
+									"count_unit": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{lifecyclePolicyCountUnitDays}, false),
+									},
+									"tag_pattern_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"tag_prefix_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+									"tag_status": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      lifecyclePolicyTagStatusAny,
+										ValidateFunc: validation.StringInSlice(lifecyclePolicyTagStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type lifecyclePolicyDocument struct {
+	Rules []*lifecyclePolicyDocumentRule `json:"rules"`
+}
+
+type lifecyclePolicyDocumentRule struct {
+	RulePriority int                                   `json:"rulePriority"`
+	Description  string                                `json:"description,omitempty"`
+	Selection    *lifecyclePolicyDocumentRuleSelection `json:"selection"`
+	Action       *lifecyclePolicyDocumentRuleAction    `json:"action"`
+}
+
+type lifecyclePolicyDocumentRuleSelection struct {
+	TagStatus      string   `json:"tagStatus"`
+	TagPatternList []string `json:"tagPatternList,omitempty"`
+	TagPrefixList  []string `json:"tagPrefixList,omitempty"`
+	CountType      string   `json:"countType"`
+	CountUnit      string   `json:"countUnit,omitempty"`
+	CountNumber    int      `json:"countNumber"`
+}
+
+type lifecyclePolicyDocumentRuleAction struct {
+	Type string `json:"type"`
+}
+
+func dataSourceLifecyclePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &lifecyclePolicyDocument{
+		Rules: expandLifecyclePolicyDocumentRules(d.Get("rule").([]interface{})),
+	}
+
+	if err := validateLifecyclePolicyDocument(doc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: %s", err)
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing ECR Lifecycle Policy Document: formatting JSON: %s", err)
+	}
+
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+// validateLifecyclePolicyDocument enforces the constraints that ECR applies to
+// lifecycle policies when they are put, so that mistakes surface at plan time.
+func validateLifecyclePolicyDocument(doc *lifecyclePolicyDocument) error {
+	priorities := make(map[int]struct{})
+	maxPriority := 0
+
+	for _, rule := range doc.Rules {
+		if _, ok := priorities[rule.RulePriority]; ok {
+			return fmt.Errorf("duplicate rule priority (%d). Each rule must have a unique priority", rule.RulePriority)
+		}
+		priorities[rule.RulePriority] = struct{}{}
+
+		if rule.RulePriority > maxPriority {
+			maxPriority = rule.RulePriority
+		}
+	}
+
+	for _, rule := range doc.Rules {
+		selection := rule.Selection
+
+		switch selection.TagStatus {
+		case lifecyclePolicyTagStatusTagged:
+			if len(selection.TagPatternList) == 0 && len(selection.TagPrefixList) == 0 {
+				return fmt.Errorf("rule (priority %d): tag_pattern_list or tag_prefix_list must be specified when tag_status is %q", rule.RulePriority, selection.TagStatus)
+			}
+
+			if len(selection.TagPatternList) > 0 && len(selection.TagPrefixList) > 0 {
+				return fmt.Errorf("rule (priority %d): only one of tag_pattern_list or tag_prefix_list can be specified", rule.RulePriority)
+			}
+		default:
+			if len(selection.TagPatternList) > 0 || len(selection.TagPrefixList) > 0 {
+				return fmt.Errorf("rule (priority %d): tag_pattern_list and tag_prefix_list can only be specified when tag_status is %q", rule.RulePriority, lifecyclePolicyTagStatusTagged)
+			}
+		}
+
+		if selection.TagStatus == lifecyclePolicyTagStatusAny && rule.RulePriority != maxPriority {
+			return fmt.Errorf("rule (priority %d): a rule with tag_status %q must have the highest priority value", rule.RulePriority, selection.TagStatus)
+		}
+
+		switch selection.CountType {
+		case lifecyclePolicyCountTypeImageCountMoreThan:
+			if selection.CountUnit != "" {
+				return fmt.Errorf("rule (priority %d): count_unit cannot be specified when count_type is %q", rule.RulePriority, selection.CountType)
+			}
+		case lifecyclePolicyCountTypeSinceImagePushed:
+			if selection.CountUnit == "" {
+				return fmt.Errorf("rule (priority %d): count_unit must be specified when count_type is %q", rule.RulePriority, selection.CountType)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandLifecyclePolicyDocumentRules(tfList []interface{}) []*lifecyclePolicyDocumentRule {
+	var apiObjects []*lifecyclePolicyDocumentRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lifecyclePolicyDocumentRule{
+			Action: &lifecyclePolicyDocumentRuleAction{
+				Type: lifecyclePolicyActionTypeExpire,
+			},
+			RulePriority: tfMap["priority"].(int),
+			Selection:    expandLifecyclePolicyDocumentRuleSelection(tfMap["selection"].([]interface{})),
+		}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Action.Type = v[0].(map[string]interface{})["type"].(string)
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLifecyclePolicyDocumentRuleSelection(tfList []interface{}) *lifecyclePolicyDocumentRuleSelection {
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &lifecyclePolicyDocumentRuleSelection{
+		CountNumber: tfMap["count_number"].(int),
+		CountType:   tfMap["count_type"].(string),
+		TagStatus:   tfMap["tag_status"].(string),
+	}
+
+	if v, ok := tfMap["count_unit"].(string); ok && v != "" {
+		apiObject.CountUnit = v
+	}
+
+	if v, ok := tfMap["tag_pattern_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagPatternList = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["tag_prefix_list"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TagPrefixList = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
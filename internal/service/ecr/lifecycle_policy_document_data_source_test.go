@@ -0,0 +1,340 @@
+package ecr_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccECRLifecyclePolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccLifecyclePolicyDocumentDataSourceConfig_basicExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_tagPatternList(t *testing.T) {
+	dataSourceName := "data.aws_ecr_lifecycle_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternList,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, "json", testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternListExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_duplicatePriority(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority,
+				ExpectError: regexp.MustCompile(`duplicate rule priority \(1\)`),
+			},
+		},
+	})
+}
+
+func TestAccECRLifecyclePolicyDocumentDataSource_invalidSelection(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_noRules,
+				ExpectError: regexp.MustCompile(`Insufficient rule blocks`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithoutTags,
+				ExpectError: regexp.MustCompile(`tag_pattern_list or tag_prefix_list must be specified`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithBothTagLists,
+				ExpectError: regexp.MustCompile(`only one of tag_pattern_list or tag_prefix_list can be specified`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_untaggedWithTags,
+				ExpectError: regexp.MustCompile(`can only be specified when tag_status is "tagged"`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast,
+				ExpectError: regexp.MustCompile(`must have the highest priority value`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_sinceImagePushedWithoutCountUnit,
+				ExpectError: regexp.MustCompile(`count_unit must be specified`),
+			},
+			{
+				Config:      testAccLifecyclePolicyDocumentDataSourceConfig_imageCountMoreThanWithCountUnit,
+				ExpectError: regexp.MustCompile(`count_unit cannot be specified`),
+			},
+		},
+	})
+}
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_noRules = `
+data "aws_ecr_lifecycle_policy_document" "test" {}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_basic = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority    = 1
+    description = "Expire untagged images older than 14 days"
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_unit   = "days"
+      count_number = 14
+    }
+  }
+
+  rule {
+    priority    = 2
+    description = "Keep last 30 release images"
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v", "release"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 30
+    }
+
+    action {
+      type = "expire"
+    }
+  }
+
+  rule {
+    priority = 3
+
+    selection {
+      count_type   = "imageCountMoreThan"
+      count_number = 100
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_basicExpectedJSON = `{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "description": "Expire untagged images older than 14 days",
+      "selection": {
+        "tagStatus": "untagged",
+        "countType": "sinceImagePushed",
+        "countUnit": "days",
+        "countNumber": 14
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 2,
+      "description": "Keep last 30 release images",
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPrefixList": ["v", "release"],
+        "countType": "imageCountMoreThan",
+        "countNumber": 30
+      },
+      "action": {
+        "type": "expire"
+      }
+    },
+    {
+      "rulePriority": 3,
+      "selection": {
+        "tagStatus": "any",
+        "countType": "imageCountMoreThan",
+        "countNumber": 100
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternList = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status       = "tagged"
+      tag_pattern_list = ["prod*", "*-stable"]
+      count_type       = "imageCountMoreThan"
+      count_number     = 5
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_tagPatternListExpectedJSON = `{
+  "rules": [
+    {
+      "rulePriority": 1,
+      "selection": {
+        "tagStatus": "tagged",
+        "tagPatternList": ["prod*", "*-stable"],
+        "countType": "imageCountMoreThan",
+        "countNumber": 5
+      },
+      "action": {
+        "type": "expire"
+      }
+    }
+  ]
+}`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_duplicatePriority = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 1
+
+    selection {
+      tag_status      = "tagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithoutTags = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "tagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_taggedWithBothTagLists = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status       = "tagged"
+      tag_pattern_list = ["prod*"]
+      tag_prefix_list  = ["v"]
+      count_type       = "imageCountMoreThan"
+      count_number     = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_untaggedWithTags = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status      = "untagged"
+      tag_prefix_list = ["v"]
+      count_type      = "imageCountMoreThan"
+      count_number    = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_anyNotLast = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "any"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+
+  rule {
+    priority = 2
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_sinceImagePushedWithoutCountUnit = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "sinceImagePushed"
+      count_number = 1
+    }
+  }
+}
+`
+
+const testAccLifecyclePolicyDocumentDataSourceConfig_imageCountMoreThanWithCountUnit = `
+data "aws_ecr_lifecycle_policy_document" "test" {
+  rule {
+    priority = 1
+
+    selection {
+      tag_status   = "untagged"
+      count_type   = "imageCountMoreThan"
+      count_unit   = "days"
+      count_number = 1
+    }
+  }
+}
+`
@@ -24,3 +24,19 @@ func statusConnectionState(ctx context.Context, conn *eventbridge.EventBridge, n
 		return output, aws.StringValue(output.ConnectionState), nil
 	}
 }
+
+func statusEndpointState(ctx context.Context, conn *eventbridge.EventBridge, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEndpointByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
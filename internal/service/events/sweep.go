@@ -37,6 +37,7 @@ func init() {
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
+			"aws_cloudwatch_event_endpoint",
 			"aws_cloudwatch_event_rule",
 			"aws_cloudwatch_event_target",
 			"aws_schemas_discoverer",
@@ -48,6 +49,11 @@ func init() {
 		F:    sweepConnection,
 	})
 
+	resource.AddTestSweepers("aws_cloudwatch_event_endpoint", &resource.Sweeper{
+		Name: "aws_cloudwatch_event_endpoint",
+		F:    sweepEndpoints,
+	})
+
 	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
@@ -264,6 +270,51 @@ func sweepConnection(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepEndpoints(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EventsConn()
+	input := &eventbridge.ListEndpointsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	for {
+		output, err := conn.ListEndpointsWithContext(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EventBridge Global Endpoint sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing EventBridge Global Endpoints (%s): %w", region, err)
+		}
+
+		for _, v := range output.Endpoints {
+			r := ResourceEndpoint()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EventBridge Global Endpoints (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepPermissions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
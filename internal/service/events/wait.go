@@ -2,16 +2,23 @@ package events
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	connectionCreatedTimeout = 2 * time.Minute
 	connectionDeletedTimeout = 2 * time.Minute
 	connectionUpdatedTimeout = 2 * time.Minute
+
+	endpointCreatedTimeout = 2 * time.Minute
+	endpointDeletedTimeout = 2 * time.Minute
+	endpointUpdatedTimeout = 2 * time.Minute
 )
 
 func waitConnectionCreated(ctx context.Context, conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeConnectionOutput, error) {
@@ -64,3 +71,60 @@ func waitConnectionUpdated(ctx context.Context, conn *eventbridge.EventBridge, i
 
 	return nil, err
 }
+
+func waitEndpointCreated(ctx context.Context, conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeEndpointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.EndpointStateCreating},
+		Target:  []string{eventbridge.EndpointStateActive},
+		Refresh: statusEndpointState(ctx, conn, id),
+		Timeout: endpointCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointDeleted(ctx context.Context, conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeEndpointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.EndpointStateDeleting},
+		Target:  []string{},
+		Refresh: statusEndpointState(ctx, conn, id),
+		Timeout: endpointDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEndpointUpdated(ctx context.Context, conn *eventbridge.EventBridge, id string) (*eventbridge.DescribeEndpointOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.EndpointStateUpdating},
+		Target:  []string{eventbridge.EndpointStateActive},
+		Refresh: statusEndpointState(ctx, conn, id),
+		Timeout: endpointUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*eventbridge.DescribeEndpointOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StateReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
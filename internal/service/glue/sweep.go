@@ -38,6 +38,11 @@ func init() {
 		F:    sweepCrawlers,
 	})
 
+	resource.AddTestSweepers("aws_glue_data_quality_ruleset", &resource.Sweeper{
+		Name: "aws_glue_data_quality_ruleset",
+		F:    sweepDataQualityRulesets,
+	})
+
 	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoints,
@@ -273,6 +278,48 @@ func sweepCrawlers(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func sweepDataQualityRulesets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GlueConn()
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	input := &glue.ListDataQualityRulesetsInput{}
+	err = conn.ListDataQualityRulesetsPagesWithContext(ctx, input, func(page *glue.ListDataQualityRulesetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, ruleset := range page.Rulesets {
+			r := ResourceDataQualityRuleset()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(ruleset.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+	if err != nil {
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Glue Data Quality Ruleset sweep for %s: %s", region, err)
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Glue Data Quality Rulesets: %s", err)
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Glue Data Quality Rulesets: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
 func sweepDevEndpoints(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
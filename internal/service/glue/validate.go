@@ -1,8 +1,10 @@
 package glue
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -29,3 +31,325 @@ func mapKeyInSlice(valid []string, ignoreCase bool) schema.SchemaValidateFunc {
 		return warnings, errors
 	}
 }
+
+// dqdlRuleTypes are the rule and analyzer types understood by the Data Quality Definition Language.
+// Other rule types only produce a warning, so that rule types added to the service can be used before they are listed here.
+var dqdlRuleTypes = map[string]struct{}{
+	"AggregateMatch":          {},
+	"AllStatistics":           {},
+	"ColumnCorrelation":       {},
+	"ColumnCount":             {},
+	"ColumnDataType":          {},
+	"ColumnExists":            {},
+	"ColumnLength":            {},
+	"ColumnNamesMatchPattern": {},
+	"ColumnValues":            {},
+	"Completeness":            {},
+	"CustomSql":               {},
+	"DataFreshness":           {},
+	"DatasetMatch":            {},
+	"DetectAnomalies":         {},
+	"DistinctValuesCount":     {},
+	"Entropy":                 {},
+	"IsComplete":              {},
+	"IsPrimaryKey":            {},
+	"IsUnique":                {},
+	"Mean":                    {},
+	"ReferentialIntegrity":    {},
+	"RowCount":                {},
+	"RowCountMatch":           {},
+	"SchemaMatch":             {},
+	"StandardDeviation":       {},
+	"Sum":                     {},
+	"UniqueValueRatio":        {},
+	"Uniqueness":              {},
+}
+
+// validDataQualityRuleset performs an offline syntax check of a DQDL ruleset, e.g.
+//
+//	Rules = [
+//	    IsComplete "id",
+//	    (ColumnValues "status" in ["A", "B"]) or (IsComplete "override")
+//	]
+func validDataQualityRuleset(v interface{}, k string) (ws []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %s to be string", k))
+		return ws, errs
+	}
+
+	unknown, err := parseDQDL(value)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q contains an invalid DQDL ruleset: %w", k, err))
+		return ws, errs
+	}
+
+	for _, ruleType := range unknown {
+		ws = append(ws, fmt.Sprintf("%q contains unknown DQDL rule type %q", k, ruleType))
+	}
+
+	return ws, errs
+}
+
+// parseDQDL checks the syntax of a DQDL ruleset and returns any rule types not in dqdlRuleTypes.
+func parseDQDL(s string) ([]string, error) {
+	s = stripDQDLComments(s)
+	sections := make(map[string]bool)
+	var unknown []string
+
+	for {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			break
+		}
+
+		name, rest := splitDQDLIdentifier(s)
+		if name == "" {
+			return nil, fmt.Errorf("expected section name, got %q", truncateDQDL(s))
+		}
+		if name != "Rules" && name != "Analyzers" {
+			return nil, fmt.Errorf("unsupported section %q, expected Rules or Analyzers", name)
+		}
+		if sections[name] {
+			return nil, fmt.Errorf("duplicate %s section", name)
+		}
+		sections[name] = true
+
+		rest = strings.TrimSpace(rest)
+		if !strings.HasPrefix(rest, "=") {
+			return nil, fmt.Errorf("expected \"=\" after %s", name)
+		}
+
+		rest = strings.TrimSpace(rest[1:])
+		if !strings.HasPrefix(rest, "[") {
+			return nil, fmt.Errorf("expected \"[\" after \"%s =\"", name)
+		}
+
+		end, err := matchDQDLBracket(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		entries, err := splitDQDLTopLevel(rest[1:end])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entries) == 0 {
+			return nil, fmt.Errorf("%s section is empty", name)
+		}
+
+		for _, entry := range entries {
+			ruleTypes, err := parseDQDLRule(entry)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			unknown = append(unknown, ruleTypes...)
+		}
+
+		s = rest[end+1:]
+	}
+
+	if !sections["Rules"] {
+		return nil, errors.New("missing Rules section")
+	}
+
+	return unknown, nil
+}
+
+// parseDQDLRule validates a single rule, which is either a rule type followed by its
+// arguments or parenthesized rules combined with "and"/"or", and returns any unknown rule types.
+func parseDQDLRule(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, errors.New("empty rule")
+	}
+
+	if !strings.HasPrefix(s, "(") {
+		ruleType, _ := splitDQDLIdentifier(s)
+		if ruleType == "" {
+			return nil, fmt.Errorf("expected rule type, got %q", truncateDQDL(s))
+		}
+
+		if _, ok := dqdlRuleTypes[ruleType]; !ok {
+			return []string{ruleType}, nil
+		}
+
+		return nil, nil
+	}
+
+	var unknown []string
+
+	for {
+		end, err := matchDQDLBracket(s)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleTypes, err := parseDQDLRule(s[1:end])
+		if err != nil {
+			return nil, err
+		}
+		unknown = append(unknown, ruleTypes...)
+
+		s = strings.TrimSpace(s[end+1:])
+		if s == "" {
+			return unknown, nil
+		}
+
+		operator, rest := splitDQDLIdentifier(s)
+		if operator != "and" && operator != "or" {
+			return nil, fmt.Errorf("expected \"and\" or \"or\" between composite rules, got %q", truncateDQDL(s))
+		}
+
+		s = strings.TrimSpace(rest)
+		if !strings.HasPrefix(s, "(") {
+			return nil, fmt.Errorf("expected \"(\" after %q", operator)
+		}
+	}
+}
+
+var dqdlOpeningBrackets = map[rune]rune{']': '[', ')': '(', '}': '{'}
+
+// matchDQDLBracket returns the index of the bracket closing the one at the start of s.
+func matchDQDLBracket(s string) (int, error) {
+	var stack []rune
+	var str dqdlString
+
+	for i, r := range s {
+		if str.scan(r) {
+			continue
+		}
+
+		switch r {
+		case '[', '(', '{':
+			stack = append(stack, r)
+		case ']', ')', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != dqdlOpeningBrackets[r] {
+				return 0, fmt.Errorf("unexpected %q", r)
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	if str.inString {
+		return 0, errors.New("unterminated string")
+	}
+
+	return 0, fmt.Errorf("unclosed %q", stack[len(stack)-1])
+}
+
+// splitDQDLTopLevel splits s on commas that are not nested in brackets or strings.
+func splitDQDLTopLevel(s string) ([]string, error) {
+	var entries []string
+	depth, start := 0, 0
+	var str dqdlString
+
+	for i, r := range s {
+		if str.scan(r) {
+			continue
+		}
+
+		switch r {
+		case '[', '(', '{':
+			depth++
+		case ']', ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				entries = append(entries, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if str.inString {
+		return nil, errors.New("unterminated string")
+	}
+
+	if last := s[start:]; strings.TrimSpace(last) != "" || len(entries) > 0 {
+		entries = append(entries, last)
+	}
+
+	return entries, nil
+}
+
+func splitDQDLIdentifier(s string) (string, string) {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+
+	if i == -1 {
+		return s, ""
+	}
+
+	return s[:i], s[i:]
+}
+
+// stripDQDLComments removes "#" comments that are not inside strings.
+func stripDQDLComments(s string) string {
+	var b strings.Builder
+	var str dqdlString
+	inComment := false
+
+	for _, r := range s {
+		switch {
+		case inComment:
+			if r == '\n' {
+				inComment = false
+				b.WriteRune(r)
+			}
+		case str.scan(r):
+			b.WriteRune(r)
+		case r == '#':
+			inComment = true
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// dqdlString tracks whether a DQDL ruleset is being scanned inside a double-quoted string.
+// Inside a string, a backslash escapes the next character, so \" doesn't end the string.
+type dqdlString struct {
+	inString bool
+	escaped  bool
+}
+
+// scan advances the state past r and returns whether r is part of a string, including its quotes.
+func (str *dqdlString) scan(r rune) bool {
+	switch {
+	case str.escaped:
+		str.escaped = false
+	case str.inString:
+		if r == '\\' {
+			str.escaped = true
+		} else if r == '"' {
+			str.inString = false
+		}
+	case r == '"':
+		str.inString = true
+	default:
+		return false
+	}
+
+	return true
+}
+
+// truncateDQDL returns the first line of s, shortened to 40 characters for use in error messages.
+func truncateDQDL(s string) string {
+	if i := strings.IndexAny(s, "\r\n"); i != -1 {
+		s = s[:i]
+	}
+
+	if r := []rune(s); len(r) > 40 {
+		s = string(r[:40]) + "..."
+	}
+
+	return s
+}
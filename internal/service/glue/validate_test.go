@@ -0,0 +1,112 @@
+package glue
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestValidDataQualityRuleset(t *testing.T) {
+	t.Parallel()
+
+	validRulesets := []string{
+		`Rules = [RowCount > 0]`,
+		`Rules = [
+    IsComplete "id",
+    ColumnValues "status" in ["A", "B"],
+    Completeness "colA" between 0.4 and 0.8
+]`,
+		`# Composite rules
+Rules = [
+    (IsComplete "id") and (IsUnique "id"),
+    (ColumnValues "x" > 10) or (ColumnValues "y" > 10) or (IsComplete "z")
+]`,
+		`Rules = [
+    CustomSql "select count(*) from primary" between 10 and 20 # row count
+]
+Analyzers = [
+    RowCount,
+    Completeness "id"
+]`,
+		`Rules = [ColumnValues "comment" = "not # a comment, or a ] bracket"]`,
+		`Rules = [ColumnValues "comment" = "an \"escaped\" quote, ] and # inside"]`,
+		`Rules = [ColumnValues "path" = "ends with a backslash\\", IsComplete "id"]`,
+	}
+
+	for _, v := range validRulesets {
+		warnings, errors := validDataQualityRuleset(v, "ruleset")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid DQDL ruleset: %q", v, errors)
+		}
+		if len(warnings) != 0 {
+			t.Errorf("%q should not produce warnings: %q", v, warnings)
+		}
+	}
+
+	invalidRulesets := []string{
+		``,
+		`RowCount > 0`,
+		`Rules = []`,
+		`Rules = [RowCount > 0`,
+		`Rules = [RowCount > 0]]`,
+		`Rules = ["id" > 0]`,
+		`Rules = [RowCount > 0,]`,
+		`Rules = [IsComplete "id]`,
+		`Rules = [IsComplete "id\"]`,
+		`Rules = [(IsComplete "id") xor (IsUnique "id")]`,
+		`Rules = [(IsComplete "id") and]`,
+		`Rules = [RowCount > 0] Rules = [RowCount > 1]`,
+		`Analyzers = [RowCount]`,
+		`Checks = [RowCount > 0]`,
+	}
+
+	warningRulesets := []string{
+		`Rules = [UnknownRule "id"]`,
+		`Rules = [(IsComplete "id") and (UnknownRule "id")]`,
+	}
+
+	for _, v := range warningRulesets {
+		warnings, errors := validDataQualityRuleset(v, "ruleset")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid DQDL ruleset: %q", v, errors)
+		}
+		if len(warnings) != 1 {
+			t.Errorf("%q should produce one warning, got %q", v, warnings)
+		}
+	}
+
+	for _, v := range invalidRulesets {
+		_, errors := validDataQualityRuleset(v, "ruleset")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid DQDL ruleset", v)
+		}
+	}
+}
+
+func TestTruncateDQDL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"short", "RowCount > 0", "RowCount > 0"},
+		{"first line", "RowCount > 0\nIsComplete", "RowCount > 0"},
+		{"long", strings.Repeat("a", 50), strings.Repeat("a", 40) + "..."},
+		{"multibyte", strings.Repeat("é", 50), strings.Repeat("é", 40) + "..."},
+		{"multibyte at limit", strings.Repeat("a", 39) + "日本語", strings.Repeat("a", 39) + "日..."},
+	}
+
+	for _, testCase := range testCases {
+		got := truncateDQDL(testCase.value)
+
+		if got != testCase.expected {
+			t.Errorf("%s: got %q, expected %q", testCase.name, got, testCase.expected)
+		}
+
+		if !utf8.ValidString(got) {
+			t.Errorf("%s: %q is not valid UTF-8", testCase.name, got)
+		}
+	}
+}
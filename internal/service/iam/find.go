@@ -213,6 +213,31 @@ func FindRoleByName(ctx context.Context, conn *iam.IAM, name string) (*iam.Role,
 	return output.Role, nil
 }
 
+func FindUserByName(ctx context.Context, conn *iam.IAM, name string) (*iam.User, error) {
+	input := &iam.GetUserInput{
+		UserName: aws.String(name),
+	}
+
+	output, err := conn.GetUserWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.User == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.User, nil
+}
+
 func FindVirtualMFADevice(ctx context.Context, conn *iam.IAM, serialNum string) (*iam.VirtualMFADevice, error) {
 	input := &iam.ListVirtualMFADevicesInput{}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -257,3 +258,85 @@ func DeleteGroupPolicies(ctx context.Context, conn *iam.IAM, groupName string) e
 
 	return nil
 }
+
+func readGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string) ([]*string, error) {
+	managedPolicies := make([]*string, 0)
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	err := conn.ListAttachedGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		for _, v := range page.AttachedPolicies {
+			managedPolicies = append(managedPolicies, v.PolicyArn)
+		}
+		return !lastPage
+	})
+	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, err
+	}
+
+	return managedPolicies, nil
+}
+
+func deleteGroupPolicyAttachments(ctx context.Context, conn *iam.IAM, groupName string, managedPolicies []*string) error {
+	for _, arn := range managedPolicies {
+		err := detachPolicyFromGroup(ctx, conn, groupName, aws.StringValue(arn))
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("detaching managed policy (%s): %w", aws.StringValue(arn), err)
+		}
+	}
+
+	return nil
+}
+
+func addGroupManagedPolicies(ctx context.Context, conn *iam.IAM, groupName string, policies []*string) error {
+	var errs *multierror.Error
+	for _, arn := range policies {
+		if err := attachPolicyToGroup(ctx, conn, groupName, aws.StringValue(arn)); err != nil {
+			newErr := fmt.Errorf("attaching managed policy (%s): %w", aws.StringValue(arn), err)
+			errs = multierror.Append(errs, newErr)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func readGroupPolicyNames(ctx context.Context, conn *iam.IAM, groupName string) ([]*string, error) {
+	inlinePolicies := make([]*string, 0)
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	err := conn.ListGroupPoliciesPagesWithContext(ctx, input, func(page *iam.ListGroupPoliciesOutput, lastPage bool) bool {
+		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
+		return !lastPage
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, err
+	}
+
+	return inlinePolicies, nil
+}
+
+func deleteGroupInlinePolicies(ctx context.Context, conn *iam.IAM, groupName string, policyNames []*string) error {
+	for _, name := range policyNames {
+		input := &iam.DeleteGroupPolicyInput{
+			GroupName:  aws.String(groupName),
+			PolicyName: name,
+		}
+
+		_, err := conn.DeleteGroupPolicyWithContext(ctx, input)
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("removing inline policy (%s): %w", aws.StringValue(name), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceGroupPoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceGroupPoliciesExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceGroupPoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	groupName := d.Get("group_name").(string)
+
+	policyNames, err := readGroupPolicyNames(ctx, conn, groupName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group (%s) inline policies: %s", groupName, err)
+	}
+
+	_, remove := exclusivePoliciesDiff(aws.StringValueSlice(policyNames), flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set)))
+
+	if err := deleteGroupInlinePolicies(ctx, conn, groupName, aws.StringSlice(remove)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting IAM Group (%s) exclusive inline policies: %s", groupName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(groupName)
+	}
+
+	return append(diags, resourceGroupPoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceGroupPoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindGroupByName(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Group (%s) not found, removing IAM Group Policies Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyNames, err := readGroupPolicyNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Group Policies Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", aws.StringValueSlice(policyNames))
+	d.Set("group_name", d.Id())
+
+	return diags
+}
@@ -0,0 +1,130 @@
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validResourceName(roleNameMaxLen),
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	roleName := d.Get("role_name").(string)
+
+	policyARNs, err := readRolePolicyAttachments(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	add, remove := exclusivePoliciesDiff(aws.StringValueSlice(policyARNs), flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set)))
+
+	if err := addRoleManagedPolicies(ctx, roleName, aws.StringSlice(add), meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting IAM Role (%s) exclusive policy attachments: %s", roleName, err)
+	}
+
+	if err := deleteRolePolicyAttachments(ctx, conn, roleName, aws.StringSlice(remove)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting IAM Role (%s) exclusive policy attachments: %s", roleName, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	_, err := tfresource.RetryWhenNewResourceNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return FindRoleByName(ctx, conn, d.Id())
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing IAM Role Policy Attachments Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	policyARNs, err := readRolePolicyAttachments(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", aws.StringValueSlice(policyARNs))
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+// exclusivePoliciesDiff returns the policies in want that aren't in have,
+// and the policies in have that aren't in want.
+func exclusivePoliciesDiff(have, want []string) (add, remove []string) {
+	haveSet := make(map[string]struct{}, len(have))
+	for _, v := range have {
+		haveSet[v] = struct{}{}
+	}
+
+	wantSet := make(map[string]struct{}, len(want))
+	for _, v := range want {
+		wantSet[v] = struct{}{}
+
+		if _, ok := haveSet[v]; !ok {
+			add = append(add, v)
+		}
+	}
+
+	for _, v := range have {
+		if _, ok := wantSet[v]; !ok {
+			remove = append(remove, v)
+		}
+	}
+
+	return add, remove
+}
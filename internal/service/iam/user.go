@@ -551,3 +551,85 @@ func DeleteServiceSpecificCredentials(ctx context.Context, conn *iam.IAM, userna
 
 	return nil
 }
+
+func readUserPolicyAttachments(ctx context.Context, conn *iam.IAM, userName string) ([]*string, error) {
+	managedPolicies := make([]*string, 0)
+	input := &iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	err := conn.ListAttachedUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListAttachedUserPoliciesOutput, lastPage bool) bool {
+		for _, v := range page.AttachedPolicies {
+			managedPolicies = append(managedPolicies, v.PolicyArn)
+		}
+		return !lastPage
+	})
+	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, err
+	}
+
+	return managedPolicies, nil
+}
+
+func deleteUserPolicyAttachments(ctx context.Context, conn *iam.IAM, userName string, managedPolicies []*string) error {
+	for _, arn := range managedPolicies {
+		err := DetachPolicyFromUser(ctx, conn, userName, aws.StringValue(arn))
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("detaching managed policy (%s): %w", aws.StringValue(arn), err)
+		}
+	}
+
+	return nil
+}
+
+func addUserManagedPolicies(ctx context.Context, conn *iam.IAM, userName string, policies []*string) error {
+	var errs *multierror.Error
+	for _, arn := range policies {
+		if err := attachPolicyToUser(ctx, conn, userName, aws.StringValue(arn)); err != nil {
+			newErr := fmt.Errorf("attaching managed policy (%s): %w", aws.StringValue(arn), err)
+			errs = multierror.Append(errs, newErr)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func readUserPolicyNames(ctx context.Context, conn *iam.IAM, userName string) ([]*string, error) {
+	inlinePolicies := make([]*string, 0)
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+
+	err := conn.ListUserPoliciesPagesWithContext(ctx, input, func(page *iam.ListUserPoliciesOutput, lastPage bool) bool {
+		inlinePolicies = append(inlinePolicies, page.PolicyNames...)
+		return !lastPage
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, err
+	}
+
+	return inlinePolicies, nil
+}
+
+func deleteUserInlinePolicies(ctx context.Context, conn *iam.IAM, userName string, policyNames []*string) error {
+	for _, name := range policyNames {
+		input := &iam.DeleteUserPolicyInput{
+			UserName:   aws.String(userName),
+			PolicyName: name,
+		}
+
+		_, err := conn.DeleteUserPolicyWithContext(ctx, input)
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("removing inline policy (%s): %w", aws.StringValue(name), err)
+		}
+	}
+
+	return nil
+}
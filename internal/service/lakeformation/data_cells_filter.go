@@ -0,0 +1,317 @@
+package lakeformation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	dataCellsFilterIDPartCount = 4
+)
+
+func ResourceDataCellsFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataCellsFilterCreate,
+		ReadWithoutTimeout:   resourceDataCellsFilterRead,
+		UpdateWithoutTimeout: resourceDataCellsFilterUpdate,
+		DeleteWithoutTimeout: resourceDataCellsFilterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"column_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				ExactlyOneOf: []string{"column_names", "column_wildcard"},
+			},
+			"column_wildcard": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"column_names", "column_wildcard"},
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"row_filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_rows_wildcard": {
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ExactlyOneOf: []string{"row_filter.0.all_rows_wildcard", "row_filter.0.filter_expression"},
+						},
+						"filter_expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+							ExactlyOneOf: []string{"row_filter.0.all_rows_wildcard", "row_filter.0.filter_expression"},
+						},
+					},
+				},
+			},
+			"table_catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataCellsFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	tableCatalogID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("table_catalog_id"); ok {
+		tableCatalogID = v.(string)
+	}
+	databaseName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	name := d.Get("name").(string)
+	id, err := flex.FlattenResourceId([]string{tableCatalogID, databaseName, tableName, name}, dataCellsFilterIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &lakeformation.CreateDataCellsFilterInput{
+		TableData: expandDataCellsFilter(d, tableCatalogID),
+	}
+
+	_, err = conn.CreateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lake Formation Data Cells Filter (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataCellsFilterIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindDataCellsFilterByID(ctx, conn, parts[0], parts[1], parts[2], parts[3])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Data Cells Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("column_names", aws.StringValueSlice(output.ColumnNames))
+	if err := d.Set("column_wildcard", flattenColumnWildcard(output.ColumnWildcard)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting column_wildcard: %s", err)
+	}
+	d.Set("database_name", output.DatabaseName)
+	d.Set("name", output.Name)
+	if err := d.Set("row_filter", flattenRowFilter(output.RowFilter)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting row_filter: %s", err)
+	}
+	d.Set("table_catalog_id", output.TableCatalogId)
+	d.Set("table_name", output.TableName)
+	d.Set("version_id", output.VersionId)
+
+	return diags
+}
+
+func resourceDataCellsFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	input := &lakeformation.UpdateDataCellsFilterInput{
+		TableData: expandDataCellsFilter(d, d.Get("table_catalog_id").(string)),
+	}
+
+	_, err := conn.UpdateDataCellsFilterWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDataCellsFilterRead(ctx, d, meta)...)
+}
+
+func resourceDataCellsFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	parts, err := flex.ExpandResourceId(d.Id(), dataCellsFilterIDPartCount)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation Data Cells Filter: %s", d.Id())
+	_, err = conn.DeleteDataCellsFilterWithContext(ctx, &lakeformation.DeleteDataCellsFilterInput{
+		DatabaseName:   aws.String(parts[1]),
+		Name:           aws.String(parts[3]),
+		TableCatalogId: aws.String(parts[0]),
+		TableName:      aws.String(parts[2]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lake Formation Data Cells Filter (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandDataCellsFilter(d *schema.ResourceData, tableCatalogID string) *lakeformation.DataCellsFilter {
+	apiObject := &lakeformation.DataCellsFilter{
+		DatabaseName:   aws.String(d.Get("database_name").(string)),
+		Name:           aws.String(d.Get("name").(string)),
+		RowFilter:      expandRowFilter(d.Get("row_filter").([]interface{})),
+		TableCatalogId: aws.String(tableCatalogID),
+		TableName:      aws.String(d.Get("table_name").(string)),
+	}
+
+	if v, ok := d.GetOk("column_names"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.ColumnNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("column_wildcard"); ok && len(v.([]interface{})) > 0 {
+		apiObject.ColumnWildcard = expandColumnWildcard(v.([]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandColumnWildcard(tfList []interface{}) *lakeformation.ColumnWildcard {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &lakeformation.ColumnWildcard{}
+
+	// An empty column_wildcard block selects all columns.
+	if tfList[0] == nil {
+		return apiObject
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExcludedColumnNames = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandRowFilter(tfList []interface{}) *lakeformation.RowFilter {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lakeformation.RowFilter{}
+
+	if v, ok := tfMap["all_rows_wildcard"].(bool); ok && v {
+		apiObject.AllRowsWildcard = &lakeformation.AllRowsWildcard{}
+	}
+
+	if v, ok := tfMap["filter_expression"].(string); ok && v != "" {
+		apiObject.FilterExpression = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenColumnWildcard(apiObject *lakeformation.ColumnWildcard) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"excluded_column_names": aws.StringValueSlice(apiObject.ExcludedColumnNames),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRowFilter(apiObject *lakeformation.RowFilter) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"all_rows_wildcard": apiObject.AllRowsWildcard != nil,
+	}
+
+	if v := apiObject.FilterExpression; v != nil {
+		tfMap["filter_expression"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
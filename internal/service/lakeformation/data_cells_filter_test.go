@@ -0,0 +1,262 @@
+package lakeformation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDataCellsFilter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lakeformation.DataCellsFilter
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "my_column_12='example'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "column_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "column_names.*", "my_column_12"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "column_names.*", "my_column_22"),
+					resource.TestCheckResourceAttr(resourceName, "column_wildcard.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "database_name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.all_rows_wildcard", "false"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.filter_expression", "my_column_12='example'"),
+					acctest.CheckResourceAttrAccountID(resourceName, "table_catalog_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "my_column_12='updated'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.filter_expression", "my_column_12='updated'"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_columnWildcard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lakeformation.DataCellsFilter
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_columnWildcard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "column_wildcard.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "column_wildcard.0.excluded_column_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "column_wildcard.0.excluded_column_names.*", "my_column_22"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "row_filter.0.all_rows_wildcard", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataCellsFilter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lakeformation.DataCellsFilter
+	resourceName := "aws_lakeformation_data_cells_filter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataCellsFilterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataCellsFilterConfig_basic(rName, "my_column_12='example'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataCellsFilterExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflakeformation.ResourceDataCellsFilter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataCellsFilterExists(ctx context.Context, n string, v *lakeformation.DataCellsFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Data Cells Filter ID is set")
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 4)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn()
+
+		output, err := tflakeformation.FindDataCellsFilterByID(ctx, conn, parts[0], parts[1], parts[2], parts[3])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDataCellsFilterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lakeformation_data_cells_filter" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 4)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tflakeformation.FindDataCellsFilterByID(ctx, conn, parts[0], parts[1], parts[2], parts[3])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lake Formation Data Cells Filter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDataCellsFilterConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "my_column_12"
+      type = "date"
+    }
+
+    columns {
+      name = "my_column_22"
+      type = "timestamp"
+    }
+
+    columns {
+      name = "my_column_32"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDataCellsFilterConfig_basic(rName, filterExpression string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_name    = aws_glue_catalog_table.test.name
+
+  column_names = ["my_column_12", "my_column_22"]
+
+  row_filter {
+    filter_expression = %[2]q
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, filterExpression))
+}
+
+func testAccDataCellsFilterConfig_columnWildcard(rName string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_lakeformation_data_cells_filter" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_name    = aws_glue_catalog_table.test.name
+
+  column_wildcard {
+    excluded_column_names = ["my_column_22"]
+  }
+
+  row_filter {
+    all_rows_wildcard = true
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
@@ -10,14 +10,25 @@ func TestAccLakeFormation_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"DataCellsFilter": {
+			"basic":          testAccDataCellsFilter_basic,
+			"columnWildcard": testAccDataCellsFilter_columnWildcard,
+			"disappears":     testAccDataCellsFilter_disappears,
+		},
 		"DataLakeSettings": {
 			"basic":            testAccDataLakeSettings_basic,
 			"dataSource":       testAccDataLakeSettingsDataSource_basic,
 			"disappears":       testAccDataLakeSettings_disappears,
 			"withoutCatalogId": testAccDataLakeSettings_withoutCatalogID,
 		},
+		"OptIn": {
+			"database":   testAccOptIn_database,
+			"disappears": testAccOptIn_disappears,
+			"table":      testAccOptIn_table,
+		},
 		"PermissionsBasic": {
 			"basic":              testAccPermissions_basic,
+			"dataCellsFilter":    testAccPermissions_dataCellsFilter,
 			"database":           testAccPermissions_database,
 			"databaseIAMAllowed": testAccPermissions_databaseIAMAllowed,
 			"databaseMultiple":   testAccPermissions_databaseMultiple,
@@ -42,6 +42,7 @@ func ResourcePermissions() *schema.Resource {
 				Optional: true,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -50,6 +51,47 @@ func ResourcePermissions() *schema.Resource {
 					"table_with_columns",
 				},
 			},
+			"data_cells_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				ExactlyOneOf: []string{
+					"catalog_resource",
+					"data_cells_filter",
+					"data_location",
+					"database",
+					"lf_tag",
+					"lf_tag_policy",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_catalog_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"table_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"data_location": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -58,6 +100,7 @@ func ResourcePermissions() *schema.Resource {
 				Optional: true,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -91,6 +134,7 @@ func ResourcePermissions() *schema.Resource {
 				Optional: true,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -122,6 +166,7 @@ func ResourcePermissions() *schema.Resource {
 				MaxItems: 1,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -164,6 +209,7 @@ func ResourcePermissions() *schema.Resource {
 				MaxItems: 1,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -247,6 +293,7 @@ func ResourcePermissions() *schema.Resource {
 				Optional: true,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -299,6 +346,7 @@ func ResourcePermissions() *schema.Resource {
 				Optional: true,
 				ExactlyOneOf: []string{
 					"catalog_resource",
+					"data_cells_filter",
 					"data_location",
 					"database",
 					"lf_tag",
@@ -401,6 +449,10 @@ func resourcePermissionsCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Resource.Catalog = ExpandCatalogResource()
 	}
 
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -487,6 +539,10 @@ func resourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		input.Resource.Catalog = ExpandCatalogResource()
 	}
 
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -572,6 +628,7 @@ func resourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta i
 	if len(cleanPermissions) == 0 {
 		log.Printf("[WARN] No Lake Formation permissions (%s) found", d.Id())
 		d.Set("catalog_resource", false)
+		d.Set("data_cells_filter", nil)
 		d.Set("data_location", nil)
 		d.Set("database", nil)
 		d.Set("lf_tag", nil)
@@ -595,6 +652,14 @@ func resourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("catalog_resource", false)
 	}
 
+	if cleanPermissions[0].Resource.DataCellsFilter != nil {
+		if err := d.Set("data_cells_filter", []interface{}{flattenDataCellsFilterResource(cleanPermissions[0].Resource.DataCellsFilter)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting data_cells_filter: %s", err)
+		}
+	} else {
+		d.Set("data_cells_filter", nil)
+	}
+
 	if cleanPermissions[0].Resource.DataLocation != nil {
 		if err := d.Set("data_location", []interface{}{flattenDataLocationResource(cleanPermissions[0].Resource.DataLocation)}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting data_location: %s", err)
@@ -701,6 +766,10 @@ func resourcePermissionsDelete(ctx context.Context, d *schema.ResourceData, meta
 		input.Resource.Catalog = ExpandCatalogResource()
 	}
 
+	if v, ok := d.GetOk("data_cells_filter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Resource.DataCellsFilter = ExpandDataCellsFilterResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("data_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Resource.DataLocation = ExpandDataLocationResource(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -804,6 +873,58 @@ func ExpandCatalogResource() *lakeformation.CatalogResource {
 	return &lakeformation.CatalogResource{}
 }
 
+func ExpandDataCellsFilterResource(tfMap map[string]interface{}) *lakeformation.DataCellsFilterResource {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.DataCellsFilterResource{}
+
+	if v, ok := tfMap["database_name"].(string); ok && v != "" {
+		apiObject.DatabaseName = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_catalog_id"].(string); ok && v != "" {
+		apiObject.TableCatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataCellsFilterResource(apiObject *lakeformation.DataCellsFilterResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DatabaseName; v != nil {
+		tfMap["database_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableCatalogId; v != nil {
+		tfMap["table_catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
 func ExpandDataLocationResource(tfMap map[string]interface{}) *lakeformation.DataLocationResource {
 	if tfMap == nil {
 		return nil
@@ -279,6 +279,36 @@ func testAccPermissions_lfTagPolicy(t *testing.T) {
 	})
 }
 
+func testAccPermissions_dataCellsFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	filterName := "aws_lakeformation_data_cells_filter.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_dataCellsFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "data_cells_filter.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.database_name", filterName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.name", filterName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.table_catalog_id", filterName, "table_catalog_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "data_cells_filter.0.table_name", filterName, "table_name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0", lakeformation.PermissionDescribe),
+				),
+			},
+		},
+	})
+}
 func testAccPermissions_tableBasic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -799,6 +829,30 @@ func permissionCountForResource(ctx context.Context, conn *lakeformation.LakeFor
 		noResource = false
 	}
 
+	if v, ok := rs.Primary.Attributes["data_cells_filter.#"]; ok && v != "" && v != "0" {
+		tfMap := map[string]interface{}{}
+
+		if v := rs.Primary.Attributes["data_cells_filter.0.database_name"]; v != "" {
+			tfMap["database_name"] = v
+		}
+
+		if v := rs.Primary.Attributes["data_cells_filter.0.name"]; v != "" {
+			tfMap["name"] = v
+		}
+
+		if v := rs.Primary.Attributes["data_cells_filter.0.table_catalog_id"]; v != "" {
+			tfMap["table_catalog_id"] = v
+		}
+
+		if v := rs.Primary.Attributes["data_cells_filter.0.table_name"]; v != "" {
+			tfMap["table_name"] = v
+		}
+
+		input.Resource.DataCellsFilter = tflakeformation.ExpandDataCellsFilterResource(tfMap)
+
+		noResource = false
+	}
+
 	if v, ok := rs.Primary.Attributes["data_location.#"]; ok && v != "" && v != "0" {
 		tfMap := map[string]interface{}{}
 
@@ -1448,6 +1502,51 @@ resource "aws_lakeformation_permissions" "test" {
 `, rName)
 }
 
+func testAccPermissionsConfig_dataCellsFilter(rName string) string {
+	return acctest.ConfigCompose(testAccDataCellsFilterConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_lakeformation_data_cells_filter" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = %[1]q
+  table_name    = aws_glue_catalog_table.test.name
+
+  column_names = ["my_column_12", "my_column_22"]
+
+  row_filter {
+    filter_expression = "my_column_12='example'"
+  }
+
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["DESCRIBE"]
+  principal   = aws_iam_role.test.arn
+
+  data_cells_filter {
+    database_name    = aws_lakeformation_data_cells_filter.test.database_name
+    name             = aws_lakeformation_data_cells_filter.test.name
+    table_catalog_id = aws_lakeformation_data_cells_filter.test.table_catalog_id
+    table_name       = aws_lakeformation_data_cells_filter.test.table_name
+  }
+}
+`, rName))
+}
 func testAccPermissionsConfig_tableBasic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -0,0 +1,155 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sourcedir"
+)
+
+const (
+	// Maximum size of a deployment package that can be uploaded directly in a CreateFunction or UpdateFunctionCode request.
+	// Larger packages must be staged in S3.
+	functionZipFileMaxDirectUploadSize = 50 * 1024 * 1024
+
+	archiveFileModeDefault    fs.FileMode = 0644
+	archiveFileModeExecutable fs.FileMode = 0755
+
+	archiveCompressionLevel = flate.BestCompression
+)
+
+var (
+	// The earliest time representable in the MS-DOS date format used by ZIP archives.
+	archiveModifiedTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceDirArchive is a ZIP deployment package built from a local directory.
+type sourceDirArchive struct {
+	content []byte
+	// Base64-encoded SHA256 hash of content, the same format as the CodeSha256 reported by Lambda.
+	hash string
+}
+
+// hexHash returns the SHA256 hash of the archive's content as a hex string, suitable for use in an S3 object key.
+func (a *sourceDirArchive) hexHash() string {
+	sum := sha256.Sum256(a.content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// buildSourceDirArchive builds a reproducible ZIP deployment package from the contents of dir.
+// Files and directories matching any of the excludes glob patterns are skipped.
+func buildSourceDirArchive(dir string, excludes []string) (*sourceDirArchive, error) {
+	files, err := sourceDirArchiveFiles(dir, excludes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	if err := writeSourceDirArchive(buf, files); err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+
+	return &sourceDirArchive{
+		content: buf.Bytes(),
+		hash:    base64.StdEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+// sourceDirArchiveHash returns the base64-encoded SHA256 hash of the deployment package that
+// buildSourceDirArchive would build from the contents of dir, without holding the package in memory.
+func sourceDirArchiveHash(dir string, excludes []string) (string, error) {
+	files, err := sourceDirArchiveFiles(dir, excludes)
+
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+
+	if err := writeSourceDirArchive(h, files); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+func sourceDirArchiveFiles(dir string, excludes []string) ([]sourcedir.File, error) {
+	files, err := sourcedir.Walk(dir, excludes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s contains no files", dir)
+	}
+
+	return files, nil
+}
+
+// writeSourceDirArchive streams a ZIP archive of files to w.
+// Entries are added in the order returned by sourcedir.Walk,
+// all entries have the same modification time and file modes are normalized to 0644, or 0755 if any execute bit is set.
+// Entries are deflated at a fixed compression level. The compressor's output is stable for a given Go release,
+// so the archive's hash only changes when file names, modes or contents change, or once when a provider release
+// built with a newer Go toolchain changes the compressed bytes.
+func writeSourceDirArchive(w io.Writer, files []sourcedir.File) error {
+	zw := zip.NewWriter(w)
+	zw.RegisterCompressor(zip.Deflate, func(w io.Writer) (io.WriteCloser, error) {
+		return flate.NewWriter(w, archiveCompressionLevel)
+	})
+
+	for _, file := range files {
+		mode := archiveFileModeDefault
+		if file.Mode.Perm()&0111 != 0 {
+			mode = archiveFileModeExecutable
+		}
+
+		header := &zip.FileHeader{
+			Name:     file.Name,
+			Method:   zip.Deflate,
+			Modified: archiveModifiedTime,
+		}
+		header.SetMode(mode)
+
+		fw, err := zw.CreateHeader(header)
+
+		if err != nil {
+			return err
+		}
+
+		if err := copyFile(fw, file.Path); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func copyFile(w io.Writer, path string) error {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+
+	return err
+}
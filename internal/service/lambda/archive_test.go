@@ -0,0 +1,172 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeArchiveTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestBuildSourceDirArchive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeArchiveTestFiles(t, dir, map[string]string{
+		"index.js":              "exports.handler = async () => {};",
+		"lib/util.js":           "module.exports = {};",
+		"lib-extra/b.js":        "module.exports = {};",
+		"README.md":             "# example",
+		"node_modules/.bin/foo": "#!/bin/sh",
+		"tests/index.test.js":   "test();",
+		"bootstrap":             "#!/bin/sh",
+	})
+
+	if err := os.Chmod(filepath.Join(dir, "bootstrap"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := buildSourceDirArchive(dir, []string{"*.md", "tests", "node_modules/.bin"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive.content), int64(len(archive.content)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantNames := []string{"bootstrap", "index.js", "lib-extra/b.js", "lib/util.js"}
+
+	if got, want := len(r.File), len(wantNames); got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+
+	for i, f := range r.File {
+		if got, want := f.Name, wantNames[i]; got != want {
+			t.Errorf("entry %d: got name %q, want %q", i, got, want)
+		}
+
+		if got, want := f.Method, zip.Deflate; got != want {
+			t.Errorf("entry %s: got method %d, want %d", f.Name, got, want)
+		}
+
+		if !f.Modified.Equal(archiveModifiedTime) {
+			t.Errorf("entry %s: got modified time %s, want %s", f.Name, f.Modified, archiveModifiedTime)
+		}
+
+		wantMode := archiveFileModeDefault
+		if f.Name == "bootstrap" {
+			wantMode = archiveFileModeExecutable
+		}
+
+		if got := f.Mode().Perm(); got != wantMode {
+			t.Errorf("entry %s: got mode %s, want %s", f.Name, got, wantMode)
+		}
+	}
+}
+
+func TestBuildSourceDirArchive_deterministic(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":    "exports.handler = async () => {};",
+		"lib/util.js": "module.exports = {};",
+	}
+
+	dir1 := t.TempDir()
+	writeArchiveTestFiles(t, dir1, files)
+
+	dir2 := t.TempDir()
+	writeArchiveTestFiles(t, dir2, files)
+
+	mtime := time.Now().Add(-24 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+
+	archive1, err := buildSourceDirArchive(dir1, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive2, err := buildSourceDirArchive(dir2, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if archive1.hash != archive2.hash {
+		t.Errorf("got different hashes for identical contents: %s, %s", archive1.hash, archive2.hash)
+	}
+
+	writeArchiveTestFiles(t, dir2, map[string]string{"index.js": "exports.handler = async () => { return 1; };"})
+
+	archive3, err := buildSourceDirArchive(dir2, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if archive1.hash == archive3.hash {
+		t.Errorf("got the same hash for different contents: %s", archive1.hash)
+	}
+}
+
+func TestSourceDirArchiveHash(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeArchiveTestFiles(t, dir, map[string]string{
+		"index.js":    "exports.handler = async () => {};",
+		"lib/util.js": "module.exports = {};",
+		"README.md":   "# example",
+	})
+
+	archive, err := buildSourceDirArchive(dir, []string{"*.md"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := sourceDirArchiveHash(dir, []string{"*.md"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := hash, archive.hash; got != want {
+		t.Errorf("got hash %s, want %s", got, want)
+	}
+}
+
+func TestBuildSourceDirArchive_empty(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeArchiveTestFiles(t, dir, map[string]string{"README.md": "# example"})
+
+	if _, err := buildSourceDirArchive(dir, []string{"*.md"}); err == nil {
+		t.Error("expected error for directory with no files")
+	}
+}
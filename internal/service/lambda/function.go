@@ -1,10 +1,12 @@
 package lambda
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -23,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sourcedir"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -148,7 +152,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -187,7 +191,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -211,6 +215,39 @@ func ResourceFunction() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_log_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(lambda.ApplicationLogLevel_Values(), false),
+						},
+						"log_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(lambda.LogFormat_Values(), false),
+						},
+						"log_group": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"system_log_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(lambda.SystemLogLevel_Values(), false),
+						},
+					},
+				},
+			},
 			"memory_size": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -264,7 +301,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -275,7 +312,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -304,14 +341,34 @@ func ResourceFunction() *schema.Resource {
 				},
 			},
 			"source_code_hash": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"source_dir"},
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: sourcedir.ValidPattern,
+				},
+			},
+			"source_dir_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -383,6 +440,8 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashForSourceDir,
+			resetLoggingConfigWhenRemoved,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -425,6 +484,19 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if _, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, cleanup, err := sourceDirFunctionCode(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		defer cleanup()
+
+		input.Code = code
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -488,6 +560,10 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Layers = flex.ExpandStringList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandLoggingConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("snap_start"); ok {
 		input.SnapStart = expandSnapStart(v.([]interface{}))
 	}
@@ -612,6 +688,9 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting layers: %s", err)
 	}
+	if err := d.Set("logging_config", flattenLoggingConfig(function.LoggingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting logging_config: %s", err)
+	}
 	d.Set("memory_size", function.MemorySize)
 	d.Set("package_type", function.PackageType)
 	if output.Concurrency != nil {
@@ -813,6 +892,14 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.Layers = flex.ExpandStringList(d.Get("layers").([]interface{}))
 		}
 
+		if d.HasChange("logging_config") {
+			if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LoggingConfig = expandLoggingConfig(v.([]interface{}))
+			} else {
+				input.LoggingConfig = defaultLoggingConfig(d.Get("function_name").(string))
+			}
+		}
+
 		if d.HasChange("memory_size") {
 			input.MemorySize = aws.Int64(int64(d.Get("memory_size").(int)))
 		}
@@ -898,6 +985,21 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			input.ZipFile = zipFile
+		} else if _, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, cleanup, err := sourceDirFunctionCode(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			defer cleanup()
+
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.ZipFile = code.ZipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -1257,12 +1359,70 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// resetLoggingConfigWhenRemoved plans Lambda's default logging configuration when logging_config is removed from
+// configuration. logging_config is Computed, so that functions without the block don't show a diff against the
+// default that Lambda reports, but without this removing the block would leave the previous settings in place.
+func resetLoggingConfigWhenRemoved(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if v := d.GetRawConfig().GetAttr("logging_config"); !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0) {
+		return nil
+	}
+
+	defaultConfig := flattenLoggingConfig(defaultLoggingConfig(d.Get("function_name").(string)))
+
+	// Nothing to reset if Lambda reports no logging configuration.
+	if v := d.Get("logging_config").([]interface{}); len(v) == 0 || reflect.DeepEqual(v[0], defaultConfig[0]) {
+		return nil
+	}
+
+	return d.SetNew("logging_config", defaultConfig)
+}
+
+// defaultLoggingConfig returns the logging configuration of a function created without one:
+// plain text logs sent to the /aws/lambda/<function name> log group.
+func defaultLoggingConfig(functionName string) *lambda.LoggingConfig {
+	return &lambda.LoggingConfig{
+		LogFormat: aws.String(lambda.LogFormatText),
+		LogGroup:  aws.String("/aws/lambda/" + functionName),
+	}
+}
+
+// updateSourceCodeHashForSourceDir sets source_code_hash to the hash of the deployment package built from source_dir,
+// so that a code update is planned only when the directory's normalized contents change.
+func updateSourceCodeHashForSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	v, ok := d.GetOk("source_dir")
+
+	if !ok {
+		return nil
+	}
+
+	hash, err := sourceDirArchiveHash(v.(string), flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return fmt.Errorf("hashing deployment package from source_dir (%s): %w", v, err)
+	}
+
+	if d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
 		d.HasChange("s3_bucket") ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
+		d.HasChange("source_dir") ||
 		d.HasChange("image_uri") ||
 		d.HasChange("architectures")
 }
@@ -1277,6 +1437,7 @@ func needsFunctionConfigUpdate(d verify.ResourceDiffer) bool {
 		d.HasChange("timeout") ||
 		d.HasChange("kms_key_arn") ||
 		d.HasChange("layers") ||
+		d.HasChange("logging_config") ||
 		d.HasChange("dead_letter_config") ||
 		d.HasChange("snap_start") ||
 		d.HasChange("tracing_config") ||
@@ -1299,6 +1460,58 @@ func readFileContents(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
+// sourceDirFunctionCode builds the deployment package for source_dir.
+// Packages larger than the direct upload limit are staged in source_dir_s3_bucket.
+// The returned function removes any staged object and should be called once Lambda has copied the code.
+func sourceDirFunctionCode(ctx context.Context, d *schema.ResourceData, meta interface{}) (*lambda.FunctionCode, func(), error) {
+	dir := d.Get("source_dir").(string)
+	archive, err := buildSourceDirArchive(dir, flex.ExpandStringValueSet(d.Get("source_dir_excludes").(*schema.Set)))
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("building deployment package from source_dir (%s): %w", dir, err)
+	}
+
+	if len(archive.content) <= functionZipFileMaxDirectUploadSize {
+		return &lambda.FunctionCode{ZipFile: archive.content}, func() {}, nil
+	}
+
+	bucket := d.Get("source_dir_s3_bucket").(string)
+
+	if bucket == "" {
+		return nil, nil, fmt.Errorf("deployment package built from source_dir (%s) is %d bytes, larger than the direct upload limit of %d bytes: source_dir_s3_bucket must be set", dir, len(archive.content), functionZipFileMaxDirectUploadSize)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn()
+	key := fmt.Sprintf("%s/%s.zip", d.Get("function_name").(string), archive.hexHash())
+
+	_, err = conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(archive.content),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("uploading deployment package built from source_dir (%s) to S3 Bucket (%s): %w", dir, bucket, err)
+	}
+
+	cleanup := func() {
+		log.Printf("[DEBUG] Deleting staged Lambda deployment package: s3://%s/%s", bucket, key)
+		_, err := conn.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Deleting staged Lambda deployment package (s3://%s/%s): %s", bucket, key, err)
+		}
+	}
+
+	return &lambda.FunctionCode{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}, cleanup, nil
+}
+
 func functionInvokeARN(functionARN string, meta interface{}) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -1418,6 +1631,48 @@ func flattenEphemeralStorage(response *lambda.EphemeralStorage) []map[string]int
 	return []map[string]interface{}{m}
 }
 
+func expandLoggingConfig(tfList []interface{}) *lambda.LoggingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &lambda.LoggingConfig{}
+
+	if v, ok := tfMap["application_log_level"].(string); ok && v != "" {
+		apiObject.ApplicationLogLevel = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_format"].(string); ok && v != "" {
+		apiObject.LogFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_group"].(string); ok && v != "" {
+		apiObject.LogGroup = aws.String(v)
+	}
+
+	if v, ok := tfMap["system_log_level"].(string); ok && v != "" {
+		apiObject.SystemLogLevel = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLoggingConfig(apiObject *lambda.LoggingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"application_log_level": aws.StringValue(apiObject.ApplicationLogLevel),
+		"log_format":            aws.StringValue(apiObject.LogFormat),
+		"log_group":             aws.StringValue(apiObject.LogGroup),
+		"system_log_level":      aws.StringValue(apiObject.SystemLogLevel),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandSnapStart(tfList []interface{}) *lambda.SnapStart {
 	snapStart := &lambda.SnapStart{ApplyOn: aws.String(lambda.SnapStartApplyOnNone)}
 	if len(tfList) == 1 && tfList[0] != nil {
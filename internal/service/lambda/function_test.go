@@ -1179,6 +1179,70 @@ func TestAccLambdaFunction_ephemeralStorage(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_loggingConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_basic(rName, rName, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.log_format", "Text"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.log_group", fmt.Sprintf("/aws/lambda/%s", rName)),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_loggingConfig(rName, "DEBUG", "INFO"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.application_log_level", "DEBUG"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.log_format", "JSON"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.log_group", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.system_log_level", "INFO"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish"},
+			},
+			{
+				Config: testAccFunctionConfig_loggingConfig(rName, "ERROR", "WARN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.application_log_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.system_log_level", "WARN"),
+				),
+			},
+			{
+				// Removing the block restores the default logging configuration.
+				Config: testAccFunctionConfig_basic(rName, rName, rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.log_format", "Text"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.0.log_group", fmt.Sprintf("/aws/lambda/%s", rName)),
+				),
+			},
+			{
+				Config:   testAccFunctionConfig_basic(rName, rName, rName, rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_tracing(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1859,6 +1923,80 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	dir := t.TempDir()
+	var sourceCodeHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, lambda.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCopyFiles(map[string]string{
+						filepath.Join(dir, "lambda.js"): "test-fixtures/lambda_func.js",
+						filepath.Join(dir, "README.md"): "test-fixtures/lambda_func.js",
+					}); err != nil {
+						t.Fatalf("error copying files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					func(s *terraform.State) error {
+						sourceCodeHash = aws.StringValue(conf.Configuration.CodeSha256)
+						return resource.TestCheckResourceAttr(resourceName, "source_code_hash", sourceCodeHash)(s)
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_dir_excludes"},
+			},
+			{
+				// Changes to excluded files and to modification times don't change the deployment package.
+				PreConfig: func() {
+					if err := testAccCopyFiles(map[string]string{
+						filepath.Join(dir, "lambda.js"): "test-fixtures/lambda_func.js",
+						filepath.Join(dir, "README.md"): "test-fixtures/lambda_func_modified.js",
+					}); err != nil {
+						t.Fatalf("error copying files: %s", err)
+					}
+				},
+				Config:   testAccFunctionConfig_sourceDir(dir, rName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if err := testAccCopyFiles(map[string]string{
+						filepath.Join(dir, "lambda.js"): "test-fixtures/lambda_func_modified.js",
+					}); err != nil {
+						t.Fatalf("error copying files: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					func(s *terraform.State) error {
+						if v := aws.StringValue(conf.Configuration.CodeSha256); v == sourceCodeHash {
+							return fmt.Errorf("Expected code hash to change from %s", sourceCodeHash)
+						}
+						return resource.TestCheckResourceAttr(resourceName, "source_code_hash", aws.StringValue(conf.Configuration.CodeSha256))(s)
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	path, zipFile, err := createTempFile("lambda_s3Update")
@@ -2272,6 +2410,22 @@ func testAccCreateZipFromFiles(files map[string]string, zipFile *os.File) error
 	return w.Flush()
 }
 
+// testAccCopyFiles copies files from source (value) to destination (key).
+func testAccCopyFiles(files map[string]string) error {
+	for destination, source := range files {
+		fileContent, err := os.ReadFile(source)
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(destination, fileContent, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func createTempFile(prefix string) (string, *os.File, error) {
 	f, err := os.CreateTemp(os.TempDir(), prefix)
 	if err != nil {
@@ -3138,6 +3292,31 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_loggingConfig(rName, applicationLogLevel, systemLogLevel string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+
+  logging_config {
+    application_log_level = %[2]q
+    log_format            = "JSON"
+    log_group             = aws_cloudwatch_log_group.test.name
+    system_log_level      = %[3]q
+  }
+}
+`, rName, applicationLogLevel, systemLogLevel))
+}
+
 func testAccFunctionConfig_updateEphemeralStorage(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3540,6 +3719,39 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(dir, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[2]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir          = %[1]q
+  source_dir_excludes = ["*.md"]
+  function_name       = %[2]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "lambda.handler"
+  runtime             = "nodejs16.x"
+}
+`, dir, rName)
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
@@ -0,0 +1,235 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	runtimeManagementConfigIDSeparator = ","
+)
+
+func ResourceRuntimeManagementConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		ReadWithoutTimeout:   resourceRuntimeManagementConfigRead,
+		UpdateWithoutTimeout: resourceRuntimeManagementConfigPut,
+		DeleteWithoutTimeout: resourceRuntimeManagementConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validFunctionName(),
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validQualifier(),
+			},
+			"runtime_version_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"update_runtime_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      lambda.UpdateRuntimeOnAuto,
+				ValidateFunc: validation.StringInSlice(lambda.UpdateRuntimeOn_Values(), false),
+			},
+		},
+
+		CustomizeDiff: checkRuntimeVersionARNForUpdateRuntimeOn,
+	}
+}
+
+func resourceRuntimeManagementConfigPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := RuntimeManagementConfigCreateID(functionName, qualifier)
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: aws.String(d.Get("update_runtime_on").(string)),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("runtime_version_arn"); ok {
+		input.RuntimeVersionArn = aws.String(v.(string))
+	}
+
+	_, err := conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Lambda Runtime Management Config (%s): %s", id, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceRuntimeManagementConfigRead(ctx, d, meta)...)
+}
+
+func resourceRuntimeManagementConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName, qualifier, err := RuntimeManagementConfigParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindRuntimeManagementConfigByTwoPartKey(ctx, conn, functionName, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Runtime Management Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", functionName)
+	d.Set("qualifier", qualifier)
+	d.Set("runtime_version_arn", output.RuntimeVersionArn)
+	d.Set("update_runtime_on", output.UpdateRuntimeOn)
+
+	return diags
+}
+
+func resourceRuntimeManagementConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaConn()
+
+	functionName, qualifier, err := RuntimeManagementConfigParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// There is no API to remove a runtime management configuration, so restore the default behavior.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    aws.String(functionName),
+		UpdateRuntimeOn: aws.String(lambda.UpdateRuntimeOnAuto),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Runtime Management Config: %s", d.Id())
+	_, err = conn.PutRuntimeManagementConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Runtime Management Config (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func checkRuntimeVersionARNForUpdateRuntimeOn(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("update_runtime_on") || !d.NewValueKnown("runtime_version_arn") {
+		return nil
+	}
+
+	updateRuntimeOn := d.Get("update_runtime_on").(string)
+	_, runtimeVersionARNOk := d.GetOk("runtime_version_arn")
+
+	if updateRuntimeOn == lambda.UpdateRuntimeOnManual && !runtimeVersionARNOk {
+		return fmt.Errorf("runtime_version_arn must be set when update_runtime_on is %q", updateRuntimeOn)
+	}
+
+	if updateRuntimeOn != lambda.UpdateRuntimeOnManual && runtimeVersionARNOk {
+		return fmt.Errorf("runtime_version_arn can only be set when update_runtime_on is %q", lambda.UpdateRuntimeOnManual)
+	}
+
+	return nil
+}
+
+func FindRuntimeManagementConfigByTwoPartKey(ctx context.Context, conn *lambda.Lambda, functionName, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfigWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func RuntimeManagementConfigCreateID(functionName, qualifier string) string {
+	if qualifier == "" {
+		return functionName
+	}
+
+	return strings.Join([]string{functionName, qualifier}, runtimeManagementConfigIDSeparator)
+}
+
+func RuntimeManagementConfigParseID(id string) (string, string, error) {
+	parts := strings.Split(id, runtimeManagementConfigIDSeparator)
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return parts[0], "", nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION_NAME or FUNCTION_NAME%[2]sQUALIFIER", id, runtimeManagementConfigIDSeparator)
+}
@@ -0,0 +1,8 @@
+package oam
+
+// Exports for use in tests only.
+var (
+	ResourceLink       = resourceLink
+	ResourceSink       = resourceSink
+	ResourceSinkPolicy = resourceSinkPolicy
+)
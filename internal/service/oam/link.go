@@ -0,0 +1,230 @@
+package oam
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_oam_link", resourceLink)
+}
+
+func resourceLink() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLinkCreate,
+		ReadWithoutTimeout:   resourceLinkRead,
+		UpdateWithoutTimeout: resourceLinkUpdate,
+		DeleteWithoutTimeout: resourceLinkDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label_template": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 50,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(oam.ResourceType_Values(), false),
+				},
+			},
+			"sink_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameLink = "Link"
+)
+
+func resourceLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	labelTemplate := d.Get("label_template").(string)
+	input := &oam.CreateLinkInput{
+		LabelTemplate:  aws.String(labelTemplate),
+		ResourceTypes:  flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)),
+		SinkIdentifier: aws.String(d.Get("sink_identifier").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateLinkWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionCreating, ResNameLink, labelTemplate, err)
+	}
+
+	if output == nil || output.Arn == nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionCreating, ResNameLink, labelTemplate, errors.New("empty output"))
+	}
+
+	d.SetId(aws.StringValue(output.Arn))
+
+	return resourceLinkRead(ctx, d, meta)
+}
+
+func resourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	link, err := FindLinkByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Observability Access Manager Link (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionReading, ResNameLink, d.Id(), err)
+	}
+
+	d.Set("arn", link.Arn)
+	d.Set("label", link.Label)
+	d.Set("label_template", link.LabelTemplate)
+	d.Set("link_id", link.Id)
+	d.Set("resource_types", aws.StringValueSlice(link.ResourceTypes))
+	d.Set("sink_arn", link.SinkArn)
+	if _, ok := d.GetOk("sink_identifier"); !ok {
+		d.Set("sink_identifier", link.SinkArn)
+	}
+
+	tags := KeyValueTags(link.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, ResNameLink, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, ResNameLink, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+
+	if d.HasChange("resource_types") {
+		input := &oam.UpdateLinkInput{
+			Identifier:    aws.String(d.Id()),
+			ResourceTypes: flex.ExpandStringSet(d.Get("resource_types").(*schema.Set)),
+		}
+
+		_, err := conn.UpdateLinkWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.ObservabilityAccessManager, create.ErrActionUpdating, ResNameLink, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
+			return create.DiagError(names.ObservabilityAccessManager, create.ErrActionUpdating, ResNameLink, d.Id(), err)
+		}
+	}
+
+	return resourceLinkRead(ctx, d, meta)
+}
+
+func resourceLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+
+	log.Printf("[INFO] Deleting CloudWatch Observability Access Manager Link: %s", d.Id())
+	_, err := conn.DeleteLinkWithContext(ctx, &oam.DeleteLinkInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, oam.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionDeleting, ResNameLink, d.Id(), err)
+	}
+
+	return nil
+}
+
+func FindLinkByID(ctx context.Context, conn *oam.OAM, id string) (*oam.GetLinkOutput, error) {
+	input := &oam.GetLinkInput{
+		Identifier: aws.String(id),
+	}
+
+	output, err := conn.GetLinkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, oam.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Arn == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
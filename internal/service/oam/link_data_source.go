@@ -0,0 +1,86 @@
+package oam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKDataSourceFactory("aws_oam_link", dataSourceLink)
+}
+
+func dataSourceLink() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLinkRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label_template": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"link_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"link_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"sink_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+const (
+	DSNameLink = "Link Data Source"
+)
+
+func dataSourceLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	linkIdentifier := d.Get("link_identifier").(string)
+	link, err := FindLinkByID(ctx, conn, linkIdentifier)
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionReading, DSNameLink, linkIdentifier, err)
+	}
+
+	d.SetId(aws.StringValue(link.Arn))
+	d.Set("arn", link.Arn)
+	d.Set("label", link.Label)
+	d.Set("label_template", link.LabelTemplate)
+	d.Set("link_id", link.Id)
+	d.Set("resource_types", aws.StringValueSlice(link.ResourceTypes))
+	d.Set("sink_arn", link.SinkArn)
+
+	if err := d.Set("tags", KeyValueTags(link.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, DSNameLink, d.Id(), err)
+	}
+
+	return nil
+}
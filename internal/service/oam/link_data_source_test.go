@@ -0,0 +1,51 @@
+package oam_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/oam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccObservabilityAccessManagerLinkDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_oam_link.test"
+	resourceName := "aws_oam_link.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionHasService(oam.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckLinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinkDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "label", resourceName, "label"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "label_template", resourceName, "label_template"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "link_id", resourceName, "link_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_types.#", resourceName, "resource_types.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sink_arn", resourceName, "sink_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinkDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLinkConfig_tags1(rName, "key1", "value1"), `
+data "aws_oam_link" "test" {
+  link_identifier = aws_oam_link.test.id
+}
+`)
+}
@@ -0,0 +1,56 @@
+package oam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/oam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccObservabilityAccessManagerSinkDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_oam_sink.test"
+	resourceName := "aws_oam_sink.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(oam.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sink_id", resourceName, "sink_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.key1", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSinkDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_oam_sink" "test" {
+  name = %[1]q
+
+  tags = {
+    key1 = "value1"
+  }
+}
+
+data "aws_oam_sink" "test" {
+  sink_identifier = aws_oam_sink.test.id
+}
+`, rName)
+}
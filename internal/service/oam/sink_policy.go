@@ -0,0 +1,168 @@
+package oam
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/oam"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_oam_sink_policy", resourceSinkPolicy)
+}
+
+func resourceSinkPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSinkPolicyPut,
+		ReadWithoutTimeout:   resourceSinkPolicyRead,
+		UpdateWithoutTimeout: resourceSinkPolicyPut,
+		DeleteWithoutTimeout: resourceSinkPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"sink_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameSinkPolicy = "Sink Policy"
+)
+
+func resourceSinkPolicyPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+
+	sinkIdentifier := d.Get("sink_identifier").(string)
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionCreating, ResNameSinkPolicy, sinkIdentifier, err)
+	}
+
+	input := &oam.PutSinkPolicyInput{
+		Policy:         aws.String(policy),
+		SinkIdentifier: aws.String(sinkIdentifier),
+	}
+
+	output, err := conn.PutSinkPolicyWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionCreating, ResNameSinkPolicy, sinkIdentifier, err)
+	}
+
+	if output == nil || output.SinkArn == nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionCreating, ResNameSinkPolicy, sinkIdentifier, errors.New("empty output"))
+	}
+
+	if d.IsNewResource() {
+		d.SetId(sinkIdentifier)
+	}
+
+	return resourceSinkPolicyRead(ctx, d, meta)
+}
+
+func resourceSinkPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ObservabilityAccessManagerConn()
+
+	output, err := FindSinkPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Observability Access Manager Sink Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionReading, ResNameSinkPolicy, d.Id(), err)
+	}
+
+	d.Set("arn", output.SinkArn)
+	d.Set("sink_id", output.SinkId)
+	d.Set("sink_identifier", d.Id())
+
+	policyToSet, err := verify.SecondJSONUnlessEquivalent(d.Get("policy").(string), aws.StringValue(output.Policy))
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, ResNameSinkPolicy, d.Id(), err)
+	}
+
+	policyToSet, err = structure.NormalizeJsonString(policyToSet)
+
+	if err != nil {
+		return create.DiagError(names.ObservabilityAccessManager, create.ErrActionSetting, ResNameSinkPolicy, d.Id(), err)
+	}
+
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceSinkPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no API to delete a sink policy. The policy is removed together with its sink.
+	log.Printf("[WARN] CloudWatch Observability Access Manager Sink Policy (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
+
+func FindSinkPolicyByID(ctx context.Context, conn *oam.OAM, id string) (*oam.GetSinkPolicyOutput, error) {
+	input := &oam.GetSinkPolicyInput{
+		SinkIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetSinkPolicyWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, oam.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
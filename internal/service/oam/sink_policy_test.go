@@ -0,0 +1,116 @@
+package oam_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/oam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfoam "github.com/hashicorp/terraform-provider-aws/internal/service/oam"
+)
+
+func TestAccObservabilityAccessManagerSinkPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sinkPolicy oam.GetSinkPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_oam_sink_policy.test"
+	sinkResourceName := "aws_oam_sink.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(oam.EndpointsID, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, oam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSinkDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSinkPolicyConfig_basic(rName, "AWS::CloudWatch::Metric"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkPolicyExists(ctx, resourceName, &sinkPolicy),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", sinkResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "sink_id", sinkResourceName, "sink_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "sink_identifier", sinkResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSinkPolicyConfig_basic(rName, "AWS::Logs::LogGroup"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSinkPolicyExists(ctx, resourceName, &sinkPolicy),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`AWS::Logs::LogGroup`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSinkPolicyExists(ctx context.Context, n string, v *oam.GetSinkPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Observability Access Manager Sink Policy ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ObservabilityAccessManagerConn()
+
+		output, err := tfoam.FindSinkPolicyByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSinkPolicyConfig_basic(rName, resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_oam_sink" "test" {
+  name = %[1]q
+}
+
+resource "aws_oam_sink_policy" "test" {
+  sink_identifier = aws_oam_sink.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["oam:CreateLink", "oam:UpdateLink"]
+      Effect   = "Allow"
+      Resource = "*"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Condition = {
+        "ForAllValues:StringEquals" = {
+          "oam:ResourceTypes" = [%[2]q]
+        }
+      }
+    }]
+  })
+}
+`, rName, resourceType)
+}
@@ -0,0 +1,234 @@
+package opensearchserverless
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_opensearchserverless_collection", resourceCollection)
+}
+
+func resourceCollection() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCollectionCreate,
+		ReadWithoutTimeout:   resourceCollectionRead,
+		UpdateWithoutTimeout: resourceCollectionUpdate,
+		DeleteWithoutTimeout: resourceCollectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 32),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.CollectionType](),
+			},
+		},
+	}
+}
+
+const (
+	ResNameCollection = "Collection"
+)
+
+func resourceCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateCollectionInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = types.CollectionType(v.(string))
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateCollection(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameCollection, name, err)
+	}
+
+	if output == nil || output.CreateCollectionDetail == nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameCollection, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(output.CreateCollectionDetail.Id))
+
+	if _, err := waitCollectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionWaitingForCreation, ResNameCollection, d.Id(), err)
+	}
+
+	return resourceCollectionRead(ctx, d, meta)
+}
+
+func resourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	output, err := FindCollectionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Collection (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameCollection, d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("collection_endpoint", output.CollectionEndpoint)
+	d.Set("dashboard_endpoint", output.DashboardEndpoint)
+	d.Set("description", output.Description)
+	d.Set("kms_key_arn", output.KmsKeyArn)
+	d.Set("name", output.Name)
+	d.Set("type", output.Type)
+
+	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameCollection, d.Id(), err)
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionSetting, ResNameCollection, d.Id(), err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionSetting, ResNameCollection, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	if d.HasChange("description") {
+		input := &opensearchserverless.UpdateCollectionInput{
+			ClientToken: aws.String(resource.UniqueId()),
+			Description: aws.String(d.Get("description").(string)),
+			Id:          aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateCollection(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameCollection, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameCollection, d.Id(), err)
+		}
+	}
+
+	return resourceCollectionRead(ctx, d, meta)
+}
+
+func resourceCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Collection: %s", d.Id())
+	_, err := conn.DeleteCollection(ctx, &opensearchserverless.DeleteCollectionInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Id:          aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameCollection, d.Id(), err)
+	}
+
+	if _, err := waitCollectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionWaitingForDeletion, ResNameCollection, d.Id(), err)
+	}
+
+	return nil
+}
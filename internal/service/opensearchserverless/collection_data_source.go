@@ -0,0 +1,107 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKDataSourceFactory("aws_opensearchserverless_collection", dataSourceCollection)
+}
+
+func dataSourceCollection() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCollectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"collection_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"kms_key_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameCollection = "Collection Data Source"
+)
+
+func dataSourceCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	var output *types.CollectionDetail
+	var err error
+
+	if v, ok := d.GetOk("id"); ok {
+		output, err = FindCollectionByID(ctx, conn, v.(string))
+	} else {
+		output, err = FindCollectionByName(ctx, conn, d.Get("name").(string))
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, "", err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+	d.Set("arn", output.Arn)
+	d.Set("collection_endpoint", output.CollectionEndpoint)
+	d.Set("dashboard_endpoint", output.DashboardEndpoint)
+	d.Set("description", output.Description)
+	d.Set("kms_key_arn", output.KmsKeyArn)
+	d.Set("name", output.Name)
+	d.Set("type", output.Type)
+
+	tags, err := ListTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, DSNameCollection, d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionSetting, DSNameCollection, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package opensearchserverless_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccOpenSearchServerlessCollectionDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceByIDName := "data.aws_opensearchserverless_collection.by_id"
+	dataSourceByNameName := "data.aws_opensearchserverless_collection.by_name"
+	resourceName := "aws_opensearchserverless_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(names.OpenSearchServerlessEndpointID, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.OpenSearchServerlessEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "collection_endpoint", resourceName, "collection_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "dashboard_endpoint", resourceName, "dashboard_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "kms_key_arn", resourceName, "kms_key_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceByIDName, "type", resourceName, "type"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceByNameName, "id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCollectionDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCollectionConfig_base(rName), fmt.Sprintf(`
+resource "aws_opensearchserverless_collection" "test" {
+  name        = %[1]q
+  description = "test"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_opensearchserverless_security_policy.test]
+}
+
+data "aws_opensearchserverless_collection" "by_id" {
+  id = aws_opensearchserverless_collection.test.id
+}
+
+data "aws_opensearchserverless_collection" "by_name" {
+  name = aws_opensearchserverless_collection.test.name
+}
+`, rName))
+}
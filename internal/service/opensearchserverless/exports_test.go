@@ -0,0 +1,10 @@
+package opensearchserverless
+
+// Exports for use in tests only.
+var (
+	ResourceAccessPolicy    = resourceAccessPolicy
+	ResourceCollection      = resourceCollection
+	ResourceLifecyclePolicy = resourceLifecyclePolicy
+	ResourceSecurityPolicy  = resourceSecurityPolicy
+	ResourceVPCEndpoint     = resourceVPCEndpoint
+)
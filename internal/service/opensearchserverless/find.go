@@ -0,0 +1,168 @@
+package opensearchserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindCollectionByID(ctx context.Context, conn *opensearchserverless.Client, id string) (*types.CollectionDetail, error) {
+	input := &opensearchserverless.BatchGetCollectionInput{
+		Ids: []string{id},
+	}
+
+	return findCollection(ctx, conn, input)
+}
+
+func FindCollectionByName(ctx context.Context, conn *opensearchserverless.Client, name string) (*types.CollectionDetail, error) {
+	input := &opensearchserverless.BatchGetCollectionInput{
+		Names: []string{name},
+	}
+
+	return findCollection(ctx, conn, input)
+}
+
+func findCollection(ctx context.Context, conn *opensearchserverless.Client, input *opensearchserverless.BatchGetCollectionInput) (*types.CollectionDetail, error) {
+	output, err := conn.BatchGetCollection(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CollectionDetails) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.CollectionDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.CollectionDetails[0], nil
+}
+
+func FindSecurityPolicyByNameAndType(ctx context.Context, conn *opensearchserverless.Client, name, policyType string) (*types.SecurityPolicyDetail, error) {
+	input := &opensearchserverless.GetSecurityPolicyInput{
+		Name: aws.String(name),
+		Type: types.SecurityPolicyType(policyType),
+	}
+
+	output, err := conn.GetSecurityPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityPolicyDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityPolicyDetail, nil
+}
+
+func FindAccessPolicyByNameAndType(ctx context.Context, conn *opensearchserverless.Client, name, policyType string) (*types.AccessPolicyDetail, error) {
+	input := &opensearchserverless.GetAccessPolicyInput{
+		Name: aws.String(name),
+		Type: types.AccessPolicyType(policyType),
+	}
+
+	output, err := conn.GetAccessPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AccessPolicyDetail == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessPolicyDetail, nil
+}
+
+func FindLifecyclePolicyByNameAndType(ctx context.Context, conn *opensearchserverless.Client, name, policyType string) (*types.LifecyclePolicyDetail, error) {
+	input := &opensearchserverless.BatchGetLifecyclePolicyInput{
+		Identifiers: []types.LifecyclePolicyIdentifier{
+			{
+				Name: aws.String(name),
+				Type: types.LifecyclePolicyType(policyType),
+			},
+		},
+	}
+
+	output, err := conn.BatchGetLifecyclePolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LifecyclePolicyDetails) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.LifecyclePolicyDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.LifecyclePolicyDetails[0], nil
+}
+
+func FindVPCEndpointByID(ctx context.Context, conn *opensearchserverless.Client, id string) (*types.VpcEndpointDetail, error) {
+	input := &opensearchserverless.BatchGetVpcEndpointInput{
+		Ids: []string{id},
+	}
+
+	output, err := conn.BatchGetVpcEndpoint(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.VpcEndpointDetails) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.VpcEndpointDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.VpcEndpointDetails[0], nil
+}
@@ -0,0 +1,181 @@
+package opensearchserverless
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_opensearchserverless_lifecycle_policy", resourceLifecyclePolicy)
+}
+
+func resourceLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLifecyclePolicyCreate,
+		ReadWithoutTimeout:   resourceLifecyclePolicyRead,
+		UpdateWithoutTimeout: resourceLifecyclePolicyUpdate,
+		DeleteWithoutTimeout: resourceLifecyclePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPolicyName,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.LifecyclePolicyType](),
+			},
+		},
+	}
+}
+
+const (
+	ResNameLifecyclePolicy = "Lifecycle Policy"
+)
+
+func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateLifecyclePolicyInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+		Policy:      aws.String(d.Get("policy").(string)),
+		Type:        types.LifecyclePolicyType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateLifecyclePolicy(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameLifecyclePolicy, name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceLifecyclePolicyRead(ctx, d, meta)
+}
+
+func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	output, err := FindLifecyclePolicyByNameAndType(ctx, conn, d.Id(), d.Get("type").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	policy, err := output.Policy.MarshalSmithyDocument()
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("policy", string(policy))
+	d.Set("policy_version", output.PolicyVersion)
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	input := &opensearchserverless.UpdateLifecyclePolicyInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		Name:          aws.String(d.Id()),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          types.LifecyclePolicyType(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		input.Policy = aws.String(d.Get("policy").(string))
+	}
+
+	_, err := conn.UpdateLifecyclePolicy(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	return resourceLifecyclePolicyRead(ctx, d, meta)
+}
+
+func resourceLifecyclePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Lifecycle Policy: %s", d.Id())
+	_, err := conn.DeleteLifecyclePolicy(ctx, &opensearchserverless.DeleteLifecyclePolicyInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(d.Id()),
+		Type:        types.LifecyclePolicyType(d.Get("type").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameLifecyclePolicy, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package opensearchserverless
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_opensearchserverless_security_policy", resourceSecurityPolicy)
+}
+
+func resourceSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityPolicyCreate,
+		ReadWithoutTimeout:   resourceSecurityPolicyRead,
+		UpdateWithoutTimeout: resourceSecurityPolicyUpdate,
+		DeleteWithoutTimeout: resourceSecurityPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validPolicyName,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.All(validation.StringLenBetween(1, 20480), validation.StringIsJSON),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"policy_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.SecurityPolicyType](),
+			},
+		},
+	}
+}
+
+const (
+	ResNameSecurityPolicy = "Security Policy"
+)
+
+var validPolicyName = validation.All(
+	validation.StringLenBetween(3, 32),
+	validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+)
+
+func resourceSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateSecurityPolicyInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+		Policy:      aws.String(d.Get("policy").(string)),
+		Type:        types.SecurityPolicyType(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateSecurityPolicy(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameSecurityPolicy, name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceSecurityPolicyRead(ctx, d, meta)
+}
+
+func resourceSecurityPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	output, err := FindSecurityPolicyByNameAndType(ctx, conn, d.Id(), d.Get("type").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless Security Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameSecurityPolicy, d.Id(), err)
+	}
+
+	policy, err := output.Policy.MarshalSmithyDocument()
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameSecurityPolicy, d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	d.Set("policy", string(policy))
+	d.Set("policy_version", output.PolicyVersion)
+	d.Set("type", output.Type)
+
+	return nil
+}
+
+func resourceSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	input := &opensearchserverless.UpdateSecurityPolicyInput{
+		ClientToken:   aws.String(resource.UniqueId()),
+		Name:          aws.String(d.Id()),
+		PolicyVersion: aws.String(d.Get("policy_version").(string)),
+		Type:          types.SecurityPolicyType(d.Get("type").(string)),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("policy") {
+		input.Policy = aws.String(d.Get("policy").(string))
+	}
+
+	_, err := conn.UpdateSecurityPolicy(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameSecurityPolicy, d.Id(), err)
+	}
+
+	return resourceSecurityPolicyRead(ctx, d, meta)
+}
+
+func resourceSecurityPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless Security Policy: %s", d.Id())
+	_, err := conn.DeleteSecurityPolicy(ctx, &opensearchserverless.DeleteSecurityPolicyInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(d.Id()),
+		Type:        types.SecurityPolicyType(d.Get("type").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameSecurityPolicy, d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourcePolicyImport imports security, access and lifecycle policies, which
+// are only unique by the combination of their name and type.
+func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%s), expected policy-name/policy-type", d.Id())
+	}
+
+	d.SetId(parts[0])
+	d.Set("type", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
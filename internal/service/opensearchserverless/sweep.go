@@ -0,0 +1,114 @@
+//go:build sweep
+// +build sweep
+
+package opensearchserverless
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_opensearchserverless_collection", &resource.Sweeper{
+		Name: "aws_opensearchserverless_collection",
+		F:    sweepCollections,
+	})
+
+	resource.AddTestSweepers("aws_opensearchserverless_vpc_endpoint", &resource.Sweeper{
+		Name: "aws_opensearchserverless_vpc_endpoint",
+		F:    sweepVPCEndpoints,
+	})
+}
+
+func sweepCollections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchServerlessClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := opensearchserverless.NewListCollectionsPaginator(conn, &opensearchserverless.ListCollectionsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping OpenSearch Serverless Collection sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing OpenSearch Serverless Collections (%s): %w", region, err))
+			break
+		}
+
+		for _, it := range page.CollectionSummaries {
+			r := resourceCollection()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(it.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping OpenSearch Serverless Collections (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepVPCEndpoints(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchServerlessClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := opensearchserverless.NewListVpcEndpointsPaginator(conn, &opensearchserverless.ListVpcEndpointsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping OpenSearch Serverless VPC Endpoint sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing OpenSearch Serverless VPC Endpoints (%s): %w", region, err))
+			break
+		}
+
+		for _, it := range page.VpcEndpointSummaries {
+			r := resourceVPCEndpoint()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(it.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping OpenSearch Serverless VPC Endpoints (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
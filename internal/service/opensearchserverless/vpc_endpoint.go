@@ -0,0 +1,211 @@
+package opensearchserverless
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless"
+	"github.com/aws/aws-sdk-go-v2/service/opensearchserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_opensearchserverless_vpc_endpoint", resourceVPCEndpoint)
+}
+
+func resourceVPCEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCEndpointCreate,
+		ReadWithoutTimeout:   resourceVPCEndpointRead,
+		UpdateWithoutTimeout: resourceVPCEndpointUpdate,
+		DeleteWithoutTimeout: resourceVPCEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 32),
+					validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]+$`), "must start with a lowercase letter and contain only lowercase letters, numbers and hyphens"),
+				),
+			},
+			"security_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 6,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+const (
+	ResNameVPCEndpoint = "VPC Endpoint"
+)
+
+func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	name := d.Get("name").(string)
+	input := &opensearchserverless.CreateVpcEndpointInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Name:        aws.String(name),
+		SubnetIds:   flex.ExpandStringValueSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:       aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateVpcEndpoint(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameVPCEndpoint, name, err)
+	}
+
+	if output == nil || output.CreateVpcEndpointDetail == nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionCreating, ResNameVPCEndpoint, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(output.CreateVpcEndpointDetail.Id))
+
+	if _, err := waitVPCEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionWaitingForCreation, ResNameVPCEndpoint, d.Id(), err)
+	}
+
+	return resourceVPCEndpointRead(ctx, d, meta)
+}
+
+func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	output, err := FindVPCEndpointByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] OpenSearch Serverless VPC Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionReading, ResNameVPCEndpoint, d.Id(), err)
+	}
+
+	d.Set("name", output.Name)
+	d.Set("security_group_ids", output.SecurityGroupIds)
+	d.Set("subnet_ids", output.SubnetIds)
+	d.Set("vpc_id", output.VpcId)
+
+	return nil
+}
+
+func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	input := &opensearchserverless.UpdateVpcEndpointInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Id:          aws.String(d.Id()),
+	}
+
+	if d.HasChange("security_group_ids") {
+		o, n := d.GetChange("security_group_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			input.AddSecurityGroupIds = add
+		}
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			input.RemoveSecurityGroupIds = del
+		}
+	}
+
+	if d.HasChange("subnet_ids") {
+		o, n := d.GetChange("subnet_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			input.AddSubnetIds = add
+		}
+
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			input.RemoveSubnetIds = del
+		}
+	}
+
+	_, err := conn.UpdateVpcEndpoint(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionUpdating, ResNameVPCEndpoint, d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionWaitingForUpdate, ResNameVPCEndpoint, d.Id(), err)
+	}
+
+	return resourceVPCEndpointRead(ctx, d, meta)
+}
+
+func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OpenSearchServerlessClient()
+
+	log.Printf("[INFO] Deleting OpenSearch Serverless VPC Endpoint: %s", d.Id())
+	_, err := conn.DeleteVpcEndpoint(ctx, &opensearchserverless.DeleteVpcEndpointInput{
+		ClientToken: aws.String(resource.UniqueId()),
+		Id:          aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionDeleting, ResNameVPCEndpoint, d.Id(), err)
+	}
+
+	if _, err := waitVPCEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.OpenSearchServerless, create.ErrActionWaitingForDeletion, ResNameVPCEndpoint, d.Id(), err)
+	}
+
+	return nil
+}
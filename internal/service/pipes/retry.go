@@ -0,0 +1,39 @@
+package pipes
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	iamPropagationTimeout = 2 * time.Minute
+)
+
+func retryWhenIAMNotPropagated[T any](ctx context.Context, f func() (T, error)) (T, error) {
+	v, err := tfresource.RetryWhen(
+		ctx,
+		iamPropagationTimeout,
+		func() (interface{}, error) {
+			return f()
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*types.ValidationException](err, "is not authorized to perform") ||
+				errs.IsAErrorMessageContains[*types.ValidationException](err, "assume the role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return v.(T), nil
+}
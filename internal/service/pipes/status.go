@@ -0,0 +1,25 @@
+package pipes
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusPipe(ctx context.Context, conn *pipes.Client, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPipeByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.CurrentState), nil
+	}
+}
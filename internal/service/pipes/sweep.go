@@ -0,0 +1,66 @@
+//go:build sweep
+// +build sweep
+
+package pipes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_pipes_pipe", &resource.Sweeper{
+		Name: "aws_pipes_pipe",
+		F:    sweepPipes,
+	})
+}
+
+func sweepPipes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).PipesClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := pipes.NewListPipesPaginator(conn, &pipes.ListPipesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping EventBridge Pipes Pipe sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing EventBridge Pipes Pipes (%s): %w", region, err))
+			break
+		}
+
+		for _, it := range page.Pipes {
+			r := resourcePipe()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(it.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping EventBridge Pipes Pipes (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
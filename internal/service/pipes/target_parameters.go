@@ -0,0 +1,1903 @@
+package pipes
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var targetParametersConflicts = []string{
+	"target_parameters.0.batch_job_parameters",
+	"target_parameters.0.cloudwatch_logs_parameters",
+	"target_parameters.0.ecs_task_parameters",
+	"target_parameters.0.eventbridge_event_bus_parameters",
+	"target_parameters.0.http_parameters",
+	"target_parameters.0.kinesis_stream_parameters",
+	"target_parameters.0.lambda_function_parameters",
+	"target_parameters.0.redshift_data_parameters",
+	"target_parameters.0.sagemaker_pipeline_parameters",
+	"target_parameters.0.sqs_queue_parameters",
+	"target_parameters.0.step_function_state_machine_parameters",
+}
+
+func targetParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"batch_job_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.batch_job_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"array_properties": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"size": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(2, 10000),
+										},
+									},
+								},
+							},
+							"container_overrides": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"command": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem:     &schema.Schema{Type: schema.TypeString},
+										},
+										"environment": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"instance_type": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"resource_requirement": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"type": {
+														Type:             schema.TypeString,
+														Required:         true,
+														ValidateDiagFunc: enum.Validate[types.BatchResourceRequirementType](),
+													},
+													"value": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"depends_on": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 20,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"job_id": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"type": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: enum.Validate[types.BatchJobDependencyType](),
+										},
+									},
+								},
+							},
+							"job_definition": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"job_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"parameters": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"retry_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"attempts": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 10),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"cloudwatch_logs_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.cloudwatch_logs_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_stream_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"timestamp": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"ecs_task_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.ecs_task_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"capacity_provider_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 6,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"base": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 100000),
+										},
+										"capacity_provider": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+										"weight": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(0, 1000),
+										},
+									},
+								},
+							},
+							"enable_ecs_managed_tags": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"enable_execute_command": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"group": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 255),
+							},
+							"launch_type": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[types.LaunchType](),
+							},
+							"network_configuration": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"aws_vpc_configuration": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"assign_public_ip": {
+														Type:             schema.TypeString,
+														Optional:         true,
+														ValidateDiagFunc: enum.Validate[types.AssignPublicIp](),
+													},
+													"security_groups": {
+														Type:     schema.TypeSet,
+														Optional: true,
+														MaxItems: 5,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+													"subnets": {
+														Type:     schema.TypeSet,
+														Optional: true,
+														MaxItems: 16,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"overrides": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"container_override": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"command": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem:     &schema.Schema{Type: schema.TypeString},
+													},
+													"cpu": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"environment": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"name": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+																"value": {
+																	Type:     schema.TypeString,
+																	Optional: true,
+																},
+															},
+														},
+													},
+													"environment_file": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"type": {
+																	Type:             schema.TypeString,
+																	Required:         true,
+																	ValidateDiagFunc: enum.Validate[types.EcsEnvironmentFileType](),
+																},
+																"value": {
+																	Type:         schema.TypeString,
+																	Required:     true,
+																	ValidateFunc: verify.ValidARN,
+																},
+															},
+														},
+													},
+													"memory": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"memory_reservation": {
+														Type:     schema.TypeInt,
+														Optional: true,
+													},
+													"name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"resource_requirement": {
+														Type:     schema.TypeList,
+														Optional: true,
+														Elem: &schema.Resource{
+															Schema: map[string]*schema.Schema{
+																"type": {
+																	Type:             schema.TypeString,
+																	Required:         true,
+																	ValidateDiagFunc: enum.Validate[types.EcsResourceRequirementType](),
+																},
+																"value": {
+																	Type:     schema.TypeString,
+																	Required: true,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										"cpu": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"ephemeral_storage": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"size_in_gib": {
+														Type:         schema.TypeInt,
+														Required:     true,
+														ValidateFunc: validation.IntBetween(21, 200),
+													},
+												},
+											},
+										},
+										"execution_role_arn": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+										"inference_accelerator_override": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"device_name": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"device_type": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"memory": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"task_role_arn": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+								},
+							},
+							"placement_constraint": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"expression": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 2000),
+										},
+										"type": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: enum.Validate[types.PlacementConstraintType](),
+										},
+									},
+								},
+							},
+							"placement_strategy": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 5,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"field": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+										"type": {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: enum.Validate[types.PlacementStrategyType](),
+										},
+									},
+								},
+							},
+							"platform_version": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"propagate_tags": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ValidateDiagFunc: enum.Validate[types.PropagateTags](),
+							},
+							"reference_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"tags": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"task_count": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      1,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"task_definition_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"eventbridge_event_bus_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.eventbridge_event_bus_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"detail_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"endpoint_id": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 50),
+							},
+							"resources": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								MaxItems: 10,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidARN,
+								},
+							},
+							"source": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"time": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"http_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.http_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"header_parameters": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"path_parameter_values": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"query_string_parameters": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				"input_template": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 8192),
+				},
+				"kinesis_stream_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.kinesis_stream_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"partition_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						},
+					},
+				},
+				"lambda_function_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.lambda_function_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[types.PipeTargetInvocationType](),
+							},
+						},
+					},
+				},
+				"redshift_data_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.redshift_data_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"database": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+							"db_user": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"secret_manager_arn": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"sqls": {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(1, 100000),
+								},
+							},
+							"statement_name": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringLenBetween(1, 500),
+							},
+							"with_event": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"sagemaker_pipeline_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.sagemaker_pipeline_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"pipeline_parameter": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 200,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+										},
+										"value": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(0, 1024),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"sqs_queue_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.sqs_queue_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"message_deduplication_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"message_group_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"step_function_state_machine_parameters": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					MaxItems:      1,
+					ConflictsWith: conflictsWithout(targetParametersConflicts, "target_parameters.0.step_function_state_machine_parameters"),
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"invocation_type": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ValidateDiagFunc: enum.Validate[types.PipeTargetInvocationType](),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandPipeTargetParameters(tfMap map[string]interface{}) *types.PipeTargetParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetParameters{}
+
+	if v, ok := tfMap["batch_job_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BatchJobParameters = expandPipeTargetBatchJobParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["cloudwatch_logs_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogsParameters = expandPipeTargetCloudWatchLogsParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["ecs_task_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EcsTaskParameters = expandPipeTargetECSTaskParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["eventbridge_event_bus_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.EventBridgeEventBusParameters = expandPipeTargetEventBridgeEventBusParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["http_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HttpParameters = expandPipeTargetHTTPParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["input_template"].(string); ok && v != "" {
+		apiObject.InputTemplate = aws.String(v)
+	}
+
+	if v, ok := tfMap["kinesis_stream_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KinesisStreamParameters = expandPipeTargetKinesisStreamParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["lambda_function_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LambdaFunctionParameters = expandPipeTargetLambdaFunctionParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["redshift_data_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.RedshiftDataParameters = expandPipeTargetRedshiftDataParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sagemaker_pipeline_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SageMakerPipelineParameters = expandPipeTargetSageMakerPipelineParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["sqs_queue_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SqsQueueParameters = expandPipeTargetSQSQueueParameters(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["step_function_state_machine_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.StepFunctionStateMachineParameters = expandPipeTargetStateMachineParameters(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetBatchJobParameters(tfMap map[string]interface{}) *types.PipeTargetBatchJobParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetBatchJobParameters{}
+
+	if v, ok := tfMap["array_properties"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ArrayProperties = &types.BatchArrayProperties{}
+
+		if v, ok := tfMap["size"].(int); ok {
+			apiObject.ArrayProperties.Size = int32(v)
+		}
+	}
+
+	if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ContainerOverrides = expandBatchContainerOverrides(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			dependency := types.BatchJobDependency{}
+
+			if v, ok := tfMap["job_id"].(string); ok && v != "" {
+				dependency.JobId = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				dependency.Type = types.BatchJobDependencyType(v)
+			}
+
+			apiObject.DependsOn = append(apiObject.DependsOn, dependency)
+		}
+	}
+
+	if v, ok := tfMap["job_definition"].(string); ok && v != "" {
+		apiObject.JobDefinition = aws.String(v)
+	}
+
+	if v, ok := tfMap["job_name"].(string); ok && v != "" {
+		apiObject.JobName = aws.String(v)
+	}
+
+	if v, ok := tfMap["parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Parameters = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["retry_strategy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.RetryStrategy = &types.BatchRetryStrategy{}
+
+		if v, ok := tfMap["attempts"].(int); ok {
+			apiObject.RetryStrategy.Attempts = int32(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandBatchContainerOverrides(tfMap map[string]interface{}) *types.BatchContainerOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BatchContainerOverrides{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["environment"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			variable := types.BatchEnvironmentVariable{}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				variable.Name = aws.String(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				variable.Value = aws.String(v)
+			}
+
+			apiObject.Environment = append(apiObject.Environment, variable)
+		}
+	}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_requirement"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requirement := types.BatchResourceRequirement{}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				requirement.Type = types.BatchResourceRequirementType(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				requirement.Value = aws.String(v)
+			}
+
+			apiObject.ResourceRequirements = append(apiObject.ResourceRequirements, requirement)
+		}
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetCloudWatchLogsParameters(tfMap map[string]interface{}) *types.PipeTargetCloudWatchLogsParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetCloudWatchLogsParameters{}
+
+	if v, ok := tfMap["log_stream_name"].(string); ok && v != "" {
+		apiObject.LogStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].(string); ok && v != "" {
+		apiObject.Timestamp = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetECSTaskParameters(tfMap map[string]interface{}) *types.PipeTargetEcsTaskParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetEcsTaskParameters{}
+
+	if v, ok := tfMap["capacity_provider_strategy"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			item := types.CapacityProviderStrategyItem{}
+
+			if v, ok := tfMap["base"].(int); ok {
+				item.Base = int32(v)
+			}
+
+			if v, ok := tfMap["capacity_provider"].(string); ok && v != "" {
+				item.CapacityProvider = aws.String(v)
+			}
+
+			if v, ok := tfMap["weight"].(int); ok {
+				item.Weight = int32(v)
+			}
+
+			apiObject.CapacityProviderStrategy = append(apiObject.CapacityProviderStrategy, item)
+		}
+	}
+
+	if v, ok := tfMap["enable_ecs_managed_tags"].(bool); ok {
+		apiObject.EnableECSManagedTags = v
+	}
+
+	if v, ok := tfMap["enable_execute_command"].(bool); ok {
+		apiObject.EnableExecuteCommand = v
+	}
+
+	if v, ok := tfMap["group"].(string); ok && v != "" {
+		apiObject.Group = aws.String(v)
+	}
+
+	if v, ok := tfMap["launch_type"].(string); ok && v != "" {
+		apiObject.LaunchType = types.LaunchType(v)
+	}
+
+	if v, ok := tfMap["network_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.NetworkConfiguration = expandNetworkConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Overrides = expandECSTaskOverride(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["placement_constraint"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			constraint := types.PlacementConstraint{}
+
+			if v, ok := tfMap["expression"].(string); ok && v != "" {
+				constraint.Expression = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				constraint.Type = types.PlacementConstraintType(v)
+			}
+
+			apiObject.PlacementConstraints = append(apiObject.PlacementConstraints, constraint)
+		}
+	}
+
+	if v, ok := tfMap["placement_strategy"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			strategy := types.PlacementStrategy{}
+
+			if v, ok := tfMap["field"].(string); ok && v != "" {
+				strategy.Field = aws.String(v)
+			}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				strategy.Type = types.PlacementStrategyType(v)
+			}
+
+			apiObject.PlacementStrategy = append(apiObject.PlacementStrategy, strategy)
+		}
+	}
+
+	if v, ok := tfMap["platform_version"].(string); ok && v != "" {
+		apiObject.PlatformVersion = aws.String(v)
+	}
+
+	if v, ok := tfMap["propagate_tags"].(string); ok && v != "" {
+		apiObject.PropagateTags = types.PropagateTags(v)
+	}
+
+	if v, ok := tfMap["reference_id"].(string); ok && v != "" {
+		apiObject.ReferenceId = aws.String(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		for key, value := range flex.ExpandStringValueMap(v) {
+			apiObject.Tags = append(apiObject.Tags, types.Tag{
+				Key:   aws.String(key),
+				Value: aws.String(value),
+			})
+		}
+	}
+
+	if v, ok := tfMap["task_count"].(int); ok {
+		apiObject.TaskCount = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["task_definition_arn"].(string); ok && v != "" {
+		apiObject.TaskDefinitionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandNetworkConfiguration(tfMap map[string]interface{}) *types.NetworkConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.NetworkConfiguration{}
+
+	if v, ok := tfMap["aws_vpc_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.AwsvpcConfiguration = &types.AwsVpcConfiguration{}
+
+		if v, ok := tfMap["assign_public_ip"].(string); ok && v != "" {
+			apiObject.AwsvpcConfiguration.AssignPublicIp = types.AssignPublicIp(v)
+		}
+
+		if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AwsvpcConfiguration.SecurityGroups = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AwsvpcConfiguration.Subnets = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandECSTaskOverride(tfMap map[string]interface{}) *types.EcsTaskOverride {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.EcsTaskOverride{}
+
+	if v, ok := tfMap["container_override"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.ContainerOverrides = append(apiObject.ContainerOverrides, expandECSContainerOverride(tfMap))
+		}
+	}
+
+	if v, ok := tfMap["cpu"].(string); ok && v != "" {
+		apiObject.Cpu = aws.String(v)
+	}
+
+	if v, ok := tfMap["ephemeral_storage"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.EphemeralStorage = &types.EcsEphemeralStorage{}
+
+		if v, ok := tfMap["size_in_gib"].(int); ok {
+			apiObject.EphemeralStorage.SizeInGiB = int32(v)
+		}
+	}
+
+	if v, ok := tfMap["execution_role_arn"].(string); ok && v != "" {
+		apiObject.ExecutionRoleArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["inference_accelerator_override"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			override := types.EcsInferenceAcceleratorOverride{}
+
+			if v, ok := tfMap["device_name"].(string); ok && v != "" {
+				override.DeviceName = aws.String(v)
+			}
+
+			if v, ok := tfMap["device_type"].(string); ok && v != "" {
+				override.DeviceType = aws.String(v)
+			}
+
+			apiObject.InferenceAcceleratorOverrides = append(apiObject.InferenceAcceleratorOverrides, override)
+		}
+	}
+
+	if v, ok := tfMap["memory"].(string); ok && v != "" {
+		apiObject.Memory = aws.String(v)
+	}
+
+	if v, ok := tfMap["task_role_arn"].(string); ok && v != "" {
+		apiObject.TaskRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandECSContainerOverride(tfMap map[string]interface{}) types.EcsContainerOverride {
+	apiObject := types.EcsContainerOverride{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["environment"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			variable := types.EcsEnvironmentVariable{}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				variable.Name = aws.String(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				variable.Value = aws.String(v)
+			}
+
+			apiObject.Environment = append(apiObject.Environment, variable)
+		}
+	}
+
+	if v, ok := tfMap["environment_file"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			file := types.EcsEnvironmentFile{}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				file.Type = types.EcsEnvironmentFileType(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				file.Value = aws.String(v)
+			}
+
+			apiObject.EnvironmentFiles = append(apiObject.EnvironmentFiles, file)
+		}
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_requirement"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			requirement := types.EcsResourceRequirement{}
+
+			if v, ok := tfMap["type"].(string); ok && v != "" {
+				requirement.Type = types.EcsResourceRequirementType(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok && v != "" {
+				requirement.Value = aws.String(v)
+			}
+
+			apiObject.ResourceRequirements = append(apiObject.ResourceRequirements, requirement)
+		}
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetEventBridgeEventBusParameters(tfMap map[string]interface{}) *types.PipeTargetEventBridgeEventBusParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetEventBridgeEventBusParameters{}
+
+	if v, ok := tfMap["detail_type"].(string); ok && v != "" {
+		apiObject.DetailType = aws.String(v)
+	}
+
+	if v, ok := tfMap["endpoint_id"].(string); ok && v != "" {
+		apiObject.EndpointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["resources"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Resources = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["source"].(string); ok && v != "" {
+		apiObject.Source = aws.String(v)
+	}
+
+	if v, ok := tfMap["time"].(string); ok && v != "" {
+		apiObject.Time = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetHTTPParameters(tfMap map[string]interface{}) *types.PipeTargetHttpParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetHttpParameters{}
+
+	if v, ok := tfMap["header_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.HeaderParameters = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["path_parameter_values"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PathParameterValues = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["query_string_parameters"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.QueryStringParameters = flex.ExpandStringValueMap(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetKinesisStreamParameters(tfMap map[string]interface{}) *types.PipeTargetKinesisStreamParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetKinesisStreamParameters{}
+
+	if v, ok := tfMap["partition_key"].(string); ok && v != "" {
+		apiObject.PartitionKey = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetLambdaFunctionParameters(tfMap map[string]interface{}) *types.PipeTargetLambdaFunctionParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetLambdaFunctionParameters{}
+
+	if v, ok := tfMap["invocation_type"].(string); ok && v != "" {
+		apiObject.InvocationType = types.PipeTargetInvocationType(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetRedshiftDataParameters(tfMap map[string]interface{}) *types.PipeTargetRedshiftDataParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetRedshiftDataParameters{}
+
+	if v, ok := tfMap["database"].(string); ok && v != "" {
+		apiObject.Database = aws.String(v)
+	}
+
+	if v, ok := tfMap["db_user"].(string); ok && v != "" {
+		apiObject.DbUser = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_manager_arn"].(string); ok && v != "" {
+		apiObject.SecretManagerArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["sqls"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Sqls = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["statement_name"].(string); ok && v != "" {
+		apiObject.StatementName = aws.String(v)
+	}
+
+	if v, ok := tfMap["with_event"].(bool); ok {
+		apiObject.WithEvent = v
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetSageMakerPipelineParameters(tfMap map[string]interface{}) *types.PipeTargetSageMakerPipelineParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetSageMakerPipelineParameters{}
+
+	if v, ok := tfMap["pipeline_parameter"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			parameter := types.SageMakerPipelineParameter{}
+
+			if v, ok := tfMap["name"].(string); ok && v != "" {
+				parameter.Name = aws.String(v)
+			}
+
+			if v, ok := tfMap["value"].(string); ok {
+				parameter.Value = aws.String(v)
+			}
+
+			apiObject.PipelineParameterList = append(apiObject.PipelineParameterList, parameter)
+		}
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetSQSQueueParameters(tfMap map[string]interface{}) *types.PipeTargetSqsQueueParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetSqsQueueParameters{}
+
+	if v, ok := tfMap["message_deduplication_id"].(string); ok && v != "" {
+		apiObject.MessageDeduplicationId = aws.String(v)
+	}
+
+	if v, ok := tfMap["message_group_id"].(string); ok && v != "" {
+		apiObject.MessageGroupId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipeTargetStateMachineParameters(tfMap map[string]interface{}) *types.PipeTargetStateMachineParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.PipeTargetStateMachineParameters{}
+
+	if v, ok := tfMap["invocation_type"].(string); ok && v != "" {
+		apiObject.InvocationType = types.PipeTargetInvocationType(v)
+	}
+
+	return apiObject
+}
+
+func flattenPipeTargetParameters(apiObject *types.PipeTargetParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.BatchJobParameters; v != nil {
+		tfMap["batch_job_parameters"] = []interface{}{flattenPipeTargetBatchJobParameters(v)}
+	}
+
+	if v := apiObject.CloudWatchLogsParameters; v != nil {
+		tfMap["cloudwatch_logs_parameters"] = []interface{}{flattenPipeTargetCloudWatchLogsParameters(v)}
+	}
+
+	if v := apiObject.EcsTaskParameters; v != nil {
+		tfMap["ecs_task_parameters"] = []interface{}{flattenPipeTargetECSTaskParameters(v)}
+	}
+
+	if v := apiObject.EventBridgeEventBusParameters; v != nil {
+		tfMap["eventbridge_event_bus_parameters"] = []interface{}{flattenPipeTargetEventBridgeEventBusParameters(v)}
+	}
+
+	if v := apiObject.HttpParameters; v != nil {
+		tfMap["http_parameters"] = []interface{}{flattenPipeTargetHTTPParameters(v)}
+	}
+
+	if v := apiObject.InputTemplate; v != nil {
+		tfMap["input_template"] = aws.ToString(v)
+	}
+
+	if v := apiObject.KinesisStreamParameters; v != nil {
+		tfMap["kinesis_stream_parameters"] = []interface{}{map[string]interface{}{
+			"partition_key": aws.ToString(v.PartitionKey),
+		}}
+	}
+
+	if v := apiObject.LambdaFunctionParameters; v != nil {
+		tfMap["lambda_function_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": string(v.InvocationType),
+		}}
+	}
+
+	if v := apiObject.RedshiftDataParameters; v != nil {
+		tfMap["redshift_data_parameters"] = []interface{}{flattenPipeTargetRedshiftDataParameters(v)}
+	}
+
+	if v := apiObject.SageMakerPipelineParameters; v != nil {
+		tfMap["sagemaker_pipeline_parameters"] = []interface{}{flattenPipeTargetSageMakerPipelineParameters(v)}
+	}
+
+	if v := apiObject.SqsQueueParameters; v != nil {
+		tfMap["sqs_queue_parameters"] = []interface{}{flattenPipeTargetSQSQueueParameters(v)}
+	}
+
+	if v := apiObject.StepFunctionStateMachineParameters; v != nil {
+		tfMap["step_function_state_machine_parameters"] = []interface{}{map[string]interface{}{
+			"invocation_type": string(v.InvocationType),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetBatchJobParameters(apiObject *types.PipeTargetBatchJobParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ArrayProperties; v != nil {
+		tfMap["array_properties"] = []interface{}{map[string]interface{}{
+			"size": v.Size,
+		}}
+	}
+
+	if v := apiObject.ContainerOverrides; v != nil {
+		tfMap["container_overrides"] = []interface{}{flattenBatchContainerOverrides(v)}
+	}
+
+	if v := apiObject.DependsOn; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"job_id": aws.ToString(apiObject.JobId),
+				"type":   string(apiObject.Type),
+			})
+		}
+
+		tfMap["depends_on"] = tfList
+	}
+
+	if v := apiObject.JobDefinition; v != nil {
+		tfMap["job_definition"] = aws.ToString(v)
+	}
+
+	if v := apiObject.JobName; v != nil {
+		tfMap["job_name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Parameters; v != nil {
+		tfMap["parameters"] = v
+	}
+
+	if v := apiObject.RetryStrategy; v != nil {
+		tfMap["retry_strategy"] = []interface{}{map[string]interface{}{
+			"attempts": v.Attempts,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenBatchContainerOverrides(apiObject *types.BatchContainerOverrides) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Command; v != nil {
+		tfMap["command"] = v
+	}
+
+	if v := apiObject.Environment; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.ToString(apiObject.Name),
+				"value": aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["environment"] = tfList
+	}
+
+	if v := apiObject.InstanceType; v != nil {
+		tfMap["instance_type"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ResourceRequirements; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"type":  string(apiObject.Type),
+				"value": aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["resource_requirement"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetCloudWatchLogsParameters(apiObject *types.PipeTargetCloudWatchLogsParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogStreamName; v != nil {
+		tfMap["log_stream_name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetECSTaskParameters(apiObject *types.PipeTargetEcsTaskParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_ecs_managed_tags": apiObject.EnableECSManagedTags,
+		"enable_execute_command":  apiObject.EnableExecuteCommand,
+		"launch_type":             string(apiObject.LaunchType),
+		"propagate_tags":          string(apiObject.PropagateTags),
+		"task_count":              aws.ToInt32(apiObject.TaskCount),
+	}
+
+	if v := apiObject.CapacityProviderStrategy; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"base":              apiObject.Base,
+				"capacity_provider": aws.ToString(apiObject.CapacityProvider),
+				"weight":            apiObject.Weight,
+			})
+		}
+
+		tfMap["capacity_provider_strategy"] = tfList
+	}
+
+	if v := apiObject.Group; v != nil {
+		tfMap["group"] = aws.ToString(v)
+	}
+
+	if v := apiObject.NetworkConfiguration; v != nil {
+		tfMap["network_configuration"] = []interface{}{flattenNetworkConfiguration(v)}
+	}
+
+	if v := apiObject.Overrides; v != nil {
+		tfMap["overrides"] = []interface{}{flattenECSTaskOverride(v)}
+	}
+
+	if v := apiObject.PlacementConstraints; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"expression": aws.ToString(apiObject.Expression),
+				"type":       string(apiObject.Type),
+			})
+		}
+
+		tfMap["placement_constraint"] = tfList
+	}
+
+	if v := apiObject.PlacementStrategy; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"field": aws.ToString(apiObject.Field),
+				"type":  string(apiObject.Type),
+			})
+		}
+
+		tfMap["placement_strategy"] = tfList
+	}
+
+	if v := apiObject.PlatformVersion; v != nil {
+		tfMap["platform_version"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ReferenceId; v != nil {
+		tfMap["reference_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Tags; v != nil {
+		tags := map[string]interface{}{}
+
+		for _, apiObject := range v {
+			tags[aws.ToString(apiObject.Key)] = aws.ToString(apiObject.Value)
+		}
+
+		tfMap["tags"] = tags
+	}
+
+	if v := apiObject.TaskDefinitionArn; v != nil {
+		tfMap["task_definition_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenNetworkConfiguration(apiObject *types.NetworkConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AwsvpcConfiguration; v != nil {
+		tfMap["aws_vpc_configuration"] = []interface{}{map[string]interface{}{
+			"assign_public_ip": string(v.AssignPublicIp),
+			"security_groups":  v.SecurityGroups,
+			"subnets":          v.Subnets,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenECSTaskOverride(apiObject *types.EcsTaskOverride) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ContainerOverrides; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, flattenECSContainerOverride(apiObject))
+		}
+
+		tfMap["container_override"] = tfList
+	}
+
+	if v := apiObject.Cpu; v != nil {
+		tfMap["cpu"] = aws.ToString(v)
+	}
+
+	if v := apiObject.EphemeralStorage; v != nil {
+		tfMap["ephemeral_storage"] = []interface{}{map[string]interface{}{
+			"size_in_gib": v.SizeInGiB,
+		}}
+	}
+
+	if v := apiObject.ExecutionRoleArn; v != nil {
+		tfMap["execution_role_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.InferenceAcceleratorOverrides; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"device_name": aws.ToString(apiObject.DeviceName),
+				"device_type": aws.ToString(apiObject.DeviceType),
+			})
+		}
+
+		tfMap["inference_accelerator_override"] = tfList
+	}
+
+	if v := apiObject.Memory; v != nil {
+		tfMap["memory"] = aws.ToString(v)
+	}
+
+	if v := apiObject.TaskRoleArn; v != nil {
+		tfMap["task_role_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenECSContainerOverride(apiObject types.EcsContainerOverride) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"cpu":                aws.ToInt32(apiObject.Cpu),
+		"memory":             aws.ToInt32(apiObject.Memory),
+		"memory_reservation": aws.ToInt32(apiObject.MemoryReservation),
+	}
+
+	if v := apiObject.Command; v != nil {
+		tfMap["command"] = v
+	}
+
+	if v := apiObject.Environment; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.ToString(apiObject.Name),
+				"value": aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["environment"] = tfList
+	}
+
+	if v := apiObject.EnvironmentFiles; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"type":  string(apiObject.Type),
+				"value": aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["environment_file"] = tfList
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.ToString(v)
+	}
+
+	if v := apiObject.ResourceRequirements; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"type":  string(apiObject.Type),
+				"value": aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["resource_requirement"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetEventBridgeEventBusParameters(apiObject *types.PipeTargetEventBridgeEventBusParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DetailType; v != nil {
+		tfMap["detail_type"] = aws.ToString(v)
+	}
+
+	if v := apiObject.EndpointId; v != nil {
+		tfMap["endpoint_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Resources; v != nil {
+		tfMap["resources"] = v
+	}
+
+	if v := apiObject.Source; v != nil {
+		tfMap["source"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Time; v != nil {
+		tfMap["time"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetHTTPParameters(apiObject *types.PipeTargetHttpParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.HeaderParameters; v != nil {
+		tfMap["header_parameters"] = v
+	}
+
+	if v := apiObject.PathParameterValues; v != nil {
+		tfMap["path_parameter_values"] = v
+	}
+
+	if v := apiObject.QueryStringParameters; v != nil {
+		tfMap["query_string_parameters"] = v
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetRedshiftDataParameters(apiObject *types.PipeTargetRedshiftDataParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"with_event": apiObject.WithEvent,
+	}
+
+	if v := apiObject.Database; v != nil {
+		tfMap["database"] = aws.ToString(v)
+	}
+
+	if v := apiObject.DbUser; v != nil {
+		tfMap["db_user"] = aws.ToString(v)
+	}
+
+	if v := apiObject.SecretManagerArn; v != nil {
+		tfMap["secret_manager_arn"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Sqls; v != nil {
+		tfMap["sqls"] = v
+	}
+
+	if v := apiObject.StatementName; v != nil {
+		tfMap["statement_name"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetSageMakerPipelineParameters(apiObject *types.PipeTargetSageMakerPipelineParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PipelineParameterList; v != nil {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"name":  aws.ToString(apiObject.Name),
+				"value": aws.ToString(apiObject.Value),
+			})
+		}
+
+		tfMap["pipeline_parameter"] = tfList
+	}
+
+	return tfMap
+}
+
+func flattenPipeTargetSQSQueueParameters(apiObject *types.PipeTargetSqsQueueParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MessageDeduplicationId; v != nil {
+		tfMap["message_deduplication_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.MessageGroupId; v != nil {
+		tfMap["message_group_id"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,401 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnalysis() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnalysisCreate,
+		ReadWithoutTimeout:   resourceAnalysisRead,
+		UpdateWithoutTimeout: resourceAnalysisUpdate,
+		DeleteWithoutTimeout: resourceAnalysisDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"analysis_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"definition": definitionSchema("definition", "source_entity"),
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"permission": permissionSchema(),
+
+			"recovery_window_in_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+				ValidateFunc: validation.Any(
+					validation.IntBetween(7, 30),
+					validation.IntInSlice([]int{0}),
+				),
+			},
+
+			"source_entity": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"definition", "source_entity"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_template": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"data_set_references": dataSetReferencesSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"theme_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	analysisId := d.Get("analysis_id").(string)
+	id := fmt.Sprintf("%s/%s", awsAccountId, analysisId)
+
+	input := &quicksight.CreateAnalysisInput{
+		AnalysisId:   aws.String(analysisId),
+		AwsAccountId: aws.String(awsAccountId),
+		Name:         aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("definition"); ok {
+		input.Definition = &quicksight.AnalysisDefinition{}
+
+		if err := expandDefinition(v.(string), input.Definition); err != nil {
+			return diag.Errorf("creating QuickSight Analysis (%s): %s", id, err)
+		}
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceEntity = expandAnalysisSourceEntity(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		input.ThemeArn = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateAnalysisWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QuickSight Analysis (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitAnalysisCreated(ctx, conn, awsAccountId, analysisId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for QuickSight Analysis (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceAnalysisRead(ctx, d, meta)
+}
+
+func resourceAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, analysisId, err := ParseAnalysisID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	analysis, err := FindAnalysisByTwoPartKey(ctx, conn, awsAccountId, analysisId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Analysis (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Analysis (%s): %s", d.Id(), err)
+	}
+
+	d.Set("analysis_id", analysis.AnalysisId)
+	d.Set("arn", analysis.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(analysis.CreatedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(analysis.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", analysis.Name)
+	d.Set("status", analysis.Status)
+	d.Set("theme_arn", analysis.ThemeArn)
+
+	definition, err := findAnalysisDefinitionByTwoPartKey(ctx, conn, awsAccountId, analysisId)
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Analysis (%s) definition: %s", d.Id(), err)
+	}
+
+	v, err := flattenDefinition(definition)
+
+	if err != nil {
+		return diag.Errorf("setting definition: %s", err)
+	}
+
+	d.Set("definition", v)
+
+	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for QuickSight Analysis (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeAnalysisPermissionsWithContext(ctx, &quicksight.DescribeAnalysisPermissionsInput{
+		AnalysisId:   aws.String(analysisId),
+		AwsAccountId: aws.String(awsAccountId),
+	})
+
+	if err != nil {
+		return diag.Errorf("describing QuickSight Analysis (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, analysisId, err := ParseAnalysisID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "recovery_window_in_days", "tags", "tags_all") {
+		input := &quicksight.UpdateAnalysisInput{
+			AnalysisId:   aws.String(analysisId),
+			AwsAccountId: aws.String(awsAccountId),
+			Name:         aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SourceEntity = expandAnalysisSourceEntity(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Definition = &quicksight.AnalysisDefinition{}
+
+			if err := expandDefinition(d.Get("definition").(string), input.Definition); err != nil {
+				return diag.Errorf("updating QuickSight Analysis (%s): %s", d.Id(), err)
+			}
+		}
+
+		if v, ok := d.GetOk("theme_arn"); ok {
+			input.ThemeArn = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateAnalysisWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Analysis (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAnalysisUpdated(ctx, conn, awsAccountId, analysisId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for QuickSight Analysis (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateAnalysisPermissionsInput{
+			AnalysisId:   aws.String(analysisId),
+			AwsAccountId: aws.String(awsAccountId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateAnalysisPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Analysis (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating QuickSight Analysis (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAnalysisRead(ctx, d, meta)
+}
+
+func resourceAnalysisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, analysisId, err := ParseAnalysisID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &quicksight.DeleteAnalysisInput{
+		AnalysisId:   aws.String(analysisId),
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	if v := d.Get("recovery_window_in_days").(int); v == 0 {
+		input.ForceDeleteWithoutRecovery = aws.Bool(true)
+	} else {
+		input.RecoveryWindowInDays = aws.Int64(int64(v))
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Analysis: %s", d.Id())
+	_, err = conn.DeleteAnalysisWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting QuickSight Analysis (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAnalysisSourceEntity(tfMap map[string]interface{}) *quicksight.AnalysisSourceEntity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.AnalysisSourceEntity{}
+
+	if v, ok := tfMap["source_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SourceTemplate = &quicksight.AnalysisSourceTemplate{
+			Arn:               aws.String(tfMap["arn"].(string)),
+			DataSetReferences: expandDataSetReferences(tfMap["data_set_references"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func ParseAnalysisID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/ANALYSIS_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
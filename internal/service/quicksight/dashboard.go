@@ -0,0 +1,646 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDashboardCreate,
+		ReadWithoutTimeout:   resourceDashboardRead,
+		UpdateWithoutTimeout: resourceDashboardUpdate,
+		DeleteWithoutTimeout: resourceDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dashboard_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"dashboard_publish_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ad_hoc_filtering_option":                  dashboardBehaviorOptionSchema(),
+						"data_point_drill_up_down_option":          dashboardBehaviorOptionSchema(),
+						"data_point_menu_label_option":             dashboardBehaviorOptionSchema(),
+						"data_point_tooltip_option":                dashboardBehaviorOptionSchema(),
+						"export_to_csv_option":                     dashboardBehaviorOptionSchema(),
+						"export_with_hidden_fields_option":         dashboardBehaviorOptionSchema(),
+						"sheet_layout_element_maximization_option": dashboardBehaviorOptionSchema(),
+						"sheet_controls_option": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"visibility_state": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.DashboardUIState_Values(), false),
+									},
+								},
+							},
+						},
+						"visual_axis_sort_option": dashboardBehaviorOptionSchema(),
+						"visual_menu_option":      dashboardBehaviorOptionSchema(),
+					},
+				},
+			},
+
+			"definition": definitionSchema("definition", "source_entity"),
+
+			"last_published_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"permission": permissionSchema(),
+
+			"source_entity": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"definition", "source_entity"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_template": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"data_set_references": dataSetReferencesSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"source_entity_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"theme_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func dashboardBehaviorOptionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"availability_status": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.DashboardBehavior_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	dashboardId := d.Get("dashboard_id").(string)
+	id := fmt.Sprintf("%s/%s", awsAccountId, dashboardId)
+
+	input := &quicksight.CreateDashboardInput{
+		AwsAccountId:       aws.String(awsAccountId),
+		DashboardId:        aws.String(dashboardId),
+		Name:               aws.String(d.Get("name").(string)),
+		VersionDescription: aws.String(d.Get("version_description").(string)),
+	}
+
+	if v, ok := d.GetOk("dashboard_publish_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DashboardPublishOptions = expandDashboardPublishOptions(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("definition"); ok {
+		input.Definition = &quicksight.DashboardVersionDefinition{}
+
+		if err := expandDefinition(v.(string), input.Definition); err != nil {
+			return diag.Errorf("creating QuickSight Dashboard (%s): %s", id, err)
+		}
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceEntity = expandDashboardSourceEntity(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("theme_arn"); ok {
+		input.ThemeArn = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDashboardWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QuickSight Dashboard (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	versionNumber, err := dashboardVersionNumberFromARN(aws.StringValue(output.VersionArn))
+
+	if err != nil {
+		return diag.Errorf("creating QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDashboardCreated(ctx, conn, awsAccountId, dashboardId, versionNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for QuickSight Dashboard (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, dashboardId, err := ParseDashboardID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Without a version number the published version is returned.
+	dashboard, err := FindDashboardByThreePartKey(ctx, conn, awsAccountId, dashboardId, 0)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dashboard.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(dashboard.CreatedTime).Format(time.RFC3339))
+	d.Set("dashboard_id", dashboard.DashboardId)
+	d.Set("last_published_time", aws.TimeValue(dashboard.LastPublishedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(dashboard.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", dashboard.Name)
+	d.Set("source_entity_arn", dashboard.Version.SourceEntityArn)
+	d.Set("status", dashboard.Version.Status)
+	d.Set("theme_arn", dashboard.Version.ThemeArn)
+	d.Set("version_description", dashboard.Version.Description)
+	d.Set("version_number", dashboard.Version.VersionNumber)
+
+	output, err := findDashboardDefinitionByThreePartKey(ctx, conn, awsAccountId, dashboardId, aws.Int64Value(dashboard.Version.VersionNumber))
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Dashboard (%s) definition: %s", d.Id(), err)
+	}
+
+	if err := d.Set("dashboard_publish_options", flattenDashboardPublishOptions(output.DashboardPublishOptions)); err != nil {
+		return diag.Errorf("setting dashboard_publish_options: %s", err)
+	}
+
+	v, err := flattenDefinition(output.Definition)
+
+	if err != nil {
+		return diag.Errorf("setting definition: %s", err)
+	}
+
+	d.Set("definition", v)
+
+	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeDashboardPermissionsWithContext(ctx, &quicksight.DescribeDashboardPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DashboardId:  aws.String(dashboardId),
+	})
+
+	if err != nil {
+		return diag.Errorf("describing QuickSight Dashboard (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dashboardId, err := ParseDashboardID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateDashboardInput{
+			AwsAccountId:       aws.String(awsAccountId),
+			DashboardId:        aws.String(dashboardId),
+			Name:               aws.String(d.Get("name").(string)),
+			VersionDescription: aws.String(d.Get("version_description").(string)),
+		}
+
+		if v, ok := d.GetOk("dashboard_publish_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DashboardPublishOptions = expandDashboardPublishOptions(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SourceEntity = expandDashboardSourceEntity(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Definition = &quicksight.DashboardVersionDefinition{}
+
+			if err := expandDefinition(d.Get("definition").(string), input.Definition); err != nil {
+				return diag.Errorf("updating QuickSight Dashboard (%s): %s", d.Id(), err)
+			}
+		}
+
+		if v, ok := d.GetOk("theme_arn"); ok {
+			input.ThemeArn = aws.String(v.(string))
+		}
+
+		output, err := conn.UpdateDashboardWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Dashboard (%s): %s", d.Id(), err)
+		}
+
+		versionNumber, err := dashboardVersionNumberFromARN(aws.StringValue(output.VersionArn))
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Dashboard (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDashboardUpdated(ctx, conn, awsAccountId, dashboardId, versionNumber, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for QuickSight Dashboard (%s) update: %s", d.Id(), err)
+		}
+
+		// Each update creates a new dashboard version which must be published explicitly.
+		_, err = conn.UpdateDashboardPublishedVersionWithContext(ctx, &quicksight.UpdateDashboardPublishedVersionInput{
+			AwsAccountId:  aws.String(awsAccountId),
+			DashboardId:   aws.String(dashboardId),
+			VersionNumber: aws.Int64(versionNumber),
+		})
+
+		if err != nil {
+			return diag.Errorf("publishing QuickSight Dashboard (%s) version %d: %s", d.Id(), versionNumber, err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateDashboardPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			DashboardId:  aws.String(dashboardId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateDashboardPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Dashboard (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating QuickSight Dashboard (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDashboardRead(ctx, d, meta)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dashboardId, err := ParseDashboardID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Dashboard: %s", d.Id())
+	_, err = conn.DeleteDashboardWithContext(ctx, &quicksight.DeleteDashboardInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DashboardId:  aws.String(dashboardId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting QuickSight Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDashboardSourceEntity(tfMap map[string]interface{}) *quicksight.DashboardSourceEntity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.DashboardSourceEntity{}
+
+	if v, ok := tfMap["source_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SourceTemplate = &quicksight.DashboardSourceTemplate{
+			Arn:               aws.String(tfMap["arn"].(string)),
+			DataSetReferences: expandDataSetReferences(tfMap["data_set_references"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandDashboardPublishOptions(tfMap map[string]interface{}) *quicksight.DashboardPublishOptions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.DashboardPublishOptions{}
+
+	if v := expandDashboardBehaviorOption(tfMap["ad_hoc_filtering_option"]); v != nil {
+		apiObject.AdHocFilteringOption = &quicksight.AdHocFilteringOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["data_point_drill_up_down_option"]); v != nil {
+		apiObject.DataPointDrillUpDownOption = &quicksight.DataPointDrillUpDownOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["data_point_menu_label_option"]); v != nil {
+		apiObject.DataPointMenuLabelOption = &quicksight.DataPointMenuLabelOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["data_point_tooltip_option"]); v != nil {
+		apiObject.DataPointTooltipOption = &quicksight.DataPointTooltipOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["export_to_csv_option"]); v != nil {
+		apiObject.ExportToCSVOption = &quicksight.ExportToCSVOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["export_with_hidden_fields_option"]); v != nil {
+		apiObject.ExportWithHiddenFieldsOption = &quicksight.ExportWithHiddenFieldsOption{AvailabilityStatus: v}
+	}
+
+	if v, ok := tfMap["sheet_controls_option"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["visibility_state"].(string); ok && v != "" {
+			apiObject.SheetControlsOption = &quicksight.SheetControlsOption{VisibilityState: aws.String(v)}
+		}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["sheet_layout_element_maximization_option"]); v != nil {
+		apiObject.SheetLayoutElementMaximizationOption = &quicksight.SheetLayoutElementMaximizationOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["visual_axis_sort_option"]); v != nil {
+		apiObject.VisualAxisSortOption = &quicksight.VisualAxisSortOption{AvailabilityStatus: v}
+	}
+
+	if v := expandDashboardBehaviorOption(tfMap["visual_menu_option"]); v != nil {
+		apiObject.VisualMenuOption = &quicksight.VisualMenuOption{AvailabilityStatus: v}
+	}
+
+	return apiObject
+}
+
+// expandDashboardBehaviorOption returns the availability status from a publish option block.
+func expandDashboardBehaviorOption(tfListRaw interface{}) *string {
+	tfList, ok := tfListRaw.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	if v, ok := tfList[0].(map[string]interface{})["availability_status"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenDashboardPublishOptions(apiObject *quicksight.DashboardPublishOptions) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AdHocFilteringOption; v != nil {
+		tfMap["ad_hoc_filtering_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.DataPointDrillUpDownOption; v != nil {
+		tfMap["data_point_drill_up_down_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.DataPointMenuLabelOption; v != nil {
+		tfMap["data_point_menu_label_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.DataPointTooltipOption; v != nil {
+		tfMap["data_point_tooltip_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.ExportToCSVOption; v != nil {
+		tfMap["export_to_csv_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.ExportWithHiddenFieldsOption; v != nil {
+		tfMap["export_with_hidden_fields_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.SheetControlsOption; v != nil {
+		tfMap["sheet_controls_option"] = []interface{}{map[string]interface{}{
+			"visibility_state": aws.StringValue(v.VisibilityState),
+		}}
+	}
+
+	if v := apiObject.SheetLayoutElementMaximizationOption; v != nil {
+		tfMap["sheet_layout_element_maximization_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.VisualAxisSortOption; v != nil {
+		tfMap["visual_axis_sort_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	if v := apiObject.VisualMenuOption; v != nil {
+		tfMap["visual_menu_option"] = flattenDashboardBehaviorOption(v.AvailabilityStatus)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDashboardBehaviorOption(availabilityStatus *string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"availability_status": aws.StringValue(availabilityStatus),
+	}}
+}
+
+// dashboardVersionNumberFromARN parses the version number from a dashboard version ARN,
+// e.g. arn:aws:quicksight:us-west-2:123456789012:dashboard/example/version/2.
+func dashboardVersionNumberFromARN(s string) (int64, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return 0, err
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) != 4 || parts[2] != "version" {
+		return 0, fmt.Errorf("unexpected format of dashboard version ARN (%s)", s)
+	}
+
+	return strconv.ParseInt(parts[3], 10, 64)
+}
+
+func ParseDashboardID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DASHBOARD_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
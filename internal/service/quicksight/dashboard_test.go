@@ -0,0 +1,251 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightDashboard_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_basic(rId, rName, "Test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("dashboard/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Test1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				// The definition read back includes defaults for the fields that aren't configured.
+				Config:             testAccDashboardConfig_basic(rId, rName, "Test1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDashboardConfig_basic(rId, rName, "Test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Test2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_basic(rId, rName, "Test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceDashboard(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightDashboard_dashboardPublishOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard quicksight.Dashboard
+	resourceName := "aws_quicksight_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_dashboardPublishOptions(rId, rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.ad_hoc_filtering_option.0.availability_status", quicksight.DashboardBehaviorDisabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_to_csv_option.0.availability_status", quicksight.DashboardBehaviorDisabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.sheet_controls_option.0.visibility_state", quicksight.DashboardUIStateCollapsed),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDashboardConfig_dashboardPublishOptions(rId, rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.ad_hoc_filtering_option.0.availability_status", quicksight.DashboardBehaviorEnabled),
+					resource.TestCheckResourceAttr(resourceName, "dashboard_publish_options.0.export_to_csv_option.0.availability_status", quicksight.DashboardBehaviorEnabled),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDashboardExists(ctx context.Context, n string, v *quicksight.Dashboard) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Dashboard ID is set")
+		}
+
+		awsAccountID, dashboardID, err := tfquicksight.ParseDashboardID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
+
+		output, err := tfquicksight.FindDashboardByThreePartKey(ctx, conn, awsAccountID, dashboardID, 0)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckDashboardDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_dashboard" {
+				continue
+			}
+
+			awsAccountID, dashboardID, err := tfquicksight.ParseDashboardID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfquicksight.FindDashboardByThreePartKey(ctx, conn, awsAccountID, dashboardID, 0)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("QuickSight Dashboard %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDashboardConfig_basic(rId, rName, versionDescription string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_basic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = %[3]q
+
+  definition = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      Identifier = "1"
+      DataSetArn = aws_quicksight_data_set.test.arn
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Name    = "Test1"
+    }]
+  })
+}
+`, rId, rName, versionDescription))
+}
+
+func testAccDashboardConfig_dashboardPublishOptions(rId, rName, availabilityStatus string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_basic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_dashboard" "test" {
+  dashboard_id        = %[1]q
+  name                = %[2]q
+  version_description = %[3]q
+
+  definition = jsonencode({
+    DataSetIdentifierDeclarations = [{
+      Identifier = "1"
+      DataSetArn = aws_quicksight_data_set.test.arn
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Name    = "Test1"
+    }]
+  })
+
+  dashboard_publish_options {
+    ad_hoc_filtering_option {
+      availability_status = %[3]q
+    }
+
+    export_to_csv_option {
+      availability_status = %[3]q
+    }
+
+    sheet_controls_option {
+      visibility_state = "COLLAPSED"
+    }
+  }
+}
+`, rId, rName, availabilityStatus))
+}
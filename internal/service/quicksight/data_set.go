@@ -0,0 +1,1062 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataSetCreate,
+		ReadWithoutTimeout:   resourceDataSetRead,
+		UpdateWithoutTimeout: resourceDataSetUpdate,
+		DeleteWithoutTimeout: resourceDataSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"data_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"import_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(quicksight.DataSetImportMode_Values(), false),
+			},
+
+			"logical_table_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"data_transforms": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 2048,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cast_column_type_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 32),
+												},
+												"new_column_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.ColumnDataType_Values(), false),
+												},
+											},
+										},
+									},
+									"create_columns_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 128,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"column_id": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 64),
+															},
+															"column_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+															"expression": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 4096),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"filter_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"condition_expression": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 4096),
+												},
+											},
+										},
+									},
+									"project_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"projected_columns": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 2000,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"rename_column_operation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"new_column_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"logical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"source": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_set_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"join_instruction": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left_operand": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"on_clause": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 512),
+												},
+												"right_operand": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 64),
+												},
+												"type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.JoinType_Values(), false),
+												},
+											},
+										},
+									},
+									"physical_table_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"output_columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"permission": permissionSchema(),
+
+			"physical_table_map": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 32,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_sql": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"columns": dataSetInputColumnsSchema(false),
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"sql_query": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 65536),
+									},
+								},
+							},
+						},
+						"physical_table_map_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"relational_table": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"input_columns": dataSetInputColumnsSchema(true),
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 64),
+									},
+									"schema": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 64),
+									},
+								},
+							},
+						},
+						"s3_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"data_source_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"input_columns": dataSetInputColumnsSchema(true),
+									"upload_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"contains_header": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"delimiter": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1),
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.FileFormat_Values(), false),
+												},
+												"start_from_row": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"text_qualifier": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.TextQualifier_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func dataSetInputColumnsSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MinItems: 1,
+		MaxItems: 2048,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(quicksight.InputColumnDataType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceDataSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	dataSetId := d.Get("data_set_id").(string)
+	id := fmt.Sprintf("%s/%s", awsAccountId, dataSetId)
+
+	input := &quicksight.CreateDataSetInput{
+		AwsAccountId:     aws.String(awsAccountId),
+		DataSetId:        aws.String(dataSetId),
+		ImportMode:       aws.String(d.Get("import_mode").(string)),
+		Name:             aws.String(d.Get("name").(string)),
+		PhysicalTableMap: expandDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+		input.LogicalTableMap = expandDataSetLogicalTableMap(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateDataSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QuickSight Data Set (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDataSetRead(ctx, d, meta)
+}
+
+func resourceDataSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataSet, err := FindDataSetByTwoPartKey(ctx, conn, awsAccountId, dataSetId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Data Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", dataSet.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("data_set_id", dataSet.DataSetId)
+	d.Set("import_mode", dataSet.ImportMode)
+	if err := d.Set("logical_table_map", flattenDataSetLogicalTableMap(dataSet.LogicalTableMap)); err != nil {
+		return diag.Errorf("setting logical_table_map: %s", err)
+	}
+	d.Set("name", dataSet.Name)
+	if err := d.Set("output_columns", flattenDataSetOutputColumns(dataSet.OutputColumns)); err != nil {
+		return diag.Errorf("setting output_columns: %s", err)
+	}
+	if err := d.Set("physical_table_map", flattenDataSetPhysicalTableMap(dataSet.PhysicalTableMap)); err != nil {
+		return diag.Errorf("setting physical_table_map: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeDataSetPermissionsWithContext(ctx, &quicksight.DescribeDataSetPermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+	})
+
+	if err != nil {
+		return diag.Errorf("describing QuickSight Data Set (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateDataSetInput{
+			AwsAccountId:     aws.String(awsAccountId),
+			DataSetId:        aws.String(dataSetId),
+			ImportMode:       aws.String(d.Get("import_mode").(string)),
+			Name:             aws.String(d.Get("name").(string)),
+			PhysicalTableMap: expandDataSetPhysicalTableMap(d.Get("physical_table_map").(*schema.Set).List()),
+		}
+
+		if v, ok := d.GetOk("logical_table_map"); ok && v.(*schema.Set).Len() > 0 {
+			input.LogicalTableMap = expandDataSetLogicalTableMap(v.(*schema.Set).List())
+		}
+
+		_, err = conn.UpdateDataSetWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Data Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateDataSetPermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			DataSetId:    aws.String(dataSetId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateDataSetPermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Data Set (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating QuickSight Data Set (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceDataSetRead(ctx, d, meta)
+}
+
+func resourceDataSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dataSetId, err := ParseDataSetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Data Set: %s", d.Id())
+	_, err = conn.DeleteDataSetWithContext(ctx, &quicksight.DeleteDataSetInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting QuickSight Data Set (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDataSetPhysicalTableMap(tfList []interface{}) map[string]*quicksight.PhysicalTable {
+	apiObjects := make(map[string]*quicksight.PhysicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.PhysicalTable{}
+
+		if v, ok := tfMap["custom_sql"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.CustomSql = &quicksight.CustomSql{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				Name:          aws.String(tfMap["name"].(string)),
+				SqlQuery:      aws.String(tfMap["sql_query"].(string)),
+			}
+
+			if v, ok := tfMap["columns"].([]interface{}); ok && len(v) > 0 {
+				apiObject.CustomSql.Columns = expandDataSetInputColumns(v)
+			}
+		}
+
+		if v, ok := tfMap["relational_table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RelationalTable = &quicksight.RelationalTable{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				InputColumns:  expandDataSetInputColumns(tfMap["input_columns"].([]interface{})),
+				Name:          aws.String(tfMap["name"].(string)),
+			}
+
+			if v, ok := tfMap["catalog"].(string); ok && v != "" {
+				apiObject.RelationalTable.Catalog = aws.String(v)
+			}
+
+			if v, ok := tfMap["schema"].(string); ok && v != "" {
+				apiObject.RelationalTable.Schema = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["s3_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.S3Source = &quicksight.S3Source{
+				DataSourceArn: aws.String(tfMap["data_source_arn"].(string)),
+				InputColumns:  expandDataSetInputColumns(tfMap["input_columns"].([]interface{})),
+			}
+
+			if v, ok := tfMap["upload_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiObject.S3Source.UploadSettings = expandDataSetUploadSettings(v[0].(map[string]interface{}))
+			}
+		}
+
+		apiObjects[tfMap["physical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDataSetInputColumns(tfList []interface{}) []*quicksight.InputColumn {
+	var apiObjects []*quicksight.InputColumn
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.InputColumn{
+			Name: aws.String(tfMap["name"].(string)),
+			Type: aws.String(tfMap["type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandDataSetUploadSettings(tfMap map[string]interface{}) *quicksight.UploadSettings {
+	apiObject := &quicksight.UploadSettings{}
+
+	if v, ok := tfMap["contains_header"].(bool); ok {
+		apiObject.ContainsHeader = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["delimiter"].(string); ok && v != "" {
+		apiObject.Delimiter = aws.String(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["start_from_row"].(int); ok && v != 0 {
+		apiObject.StartFromRow = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["text_qualifier"].(string); ok && v != "" {
+		apiObject.TextQualifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataSetLogicalTableMap(tfList []interface{}) map[string]*quicksight.LogicalTable {
+	apiObjects := make(map[string]*quicksight.LogicalTable)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.LogicalTable{
+			Alias:  aws.String(tfMap["alias"].(string)),
+			Source: &quicksight.LogicalTableSource{},
+		}
+
+		if v, ok := tfMap["data_transforms"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DataTransforms = expandDataSetDataTransforms(v)
+		}
+
+		if v, ok := tfMap["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["data_set_arn"].(string); ok && v != "" {
+				apiObject.Source.DataSetArn = aws.String(v)
+			}
+
+			if v, ok := tfMap["join_instruction"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+
+				apiObject.Source.JoinInstruction = &quicksight.JoinInstruction{
+					LeftOperand:  aws.String(tfMap["left_operand"].(string)),
+					OnClause:     aws.String(tfMap["on_clause"].(string)),
+					RightOperand: aws.String(tfMap["right_operand"].(string)),
+					Type:         aws.String(tfMap["type"].(string)),
+				}
+			}
+
+			if v, ok := tfMap["physical_table_id"].(string); ok && v != "" {
+				apiObject.Source.PhysicalTableId = aws.String(v)
+			}
+		}
+
+		apiObjects[tfMap["logical_table_map_id"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func expandDataSetDataTransforms(tfList []interface{}) []*quicksight.TransformOperation {
+	var apiObjects []*quicksight.TransformOperation
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &quicksight.TransformOperation{}
+
+		if v, ok := tfMap["cast_column_type_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.CastColumnTypeOperation = &quicksight.CastColumnTypeOperation{
+				ColumnName:    aws.String(tfMap["column_name"].(string)),
+				NewColumnType: aws.String(tfMap["new_column_type"].(string)),
+			}
+
+			if v, ok := tfMap["format"].(string); ok && v != "" {
+				apiObject.CastColumnTypeOperation.Format = aws.String(v)
+			}
+		}
+
+		if v, ok := tfMap["create_columns_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			operation := &quicksight.CreateColumnsOperation{}
+
+			for _, tfMapRaw := range tfMap["columns"].([]interface{}) {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				operation.Columns = append(operation.Columns, &quicksight.CalculatedColumn{
+					ColumnId:   aws.String(tfMap["column_id"].(string)),
+					ColumnName: aws.String(tfMap["column_name"].(string)),
+					Expression: aws.String(tfMap["expression"].(string)),
+				})
+			}
+
+			apiObject.CreateColumnsOperation = operation
+		}
+
+		if v, ok := tfMap["filter_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.FilterOperation = &quicksight.FilterOperation{
+				ConditionExpression: aws.String(tfMap["condition_expression"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["project_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.ProjectOperation = &quicksight.ProjectOperation{
+				ProjectedColumns: flex.ExpandStringList(tfMap["projected_columns"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["rename_column_operation"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			apiObject.RenameColumnOperation = &quicksight.RenameColumnOperation{
+				ColumnName:    aws.String(tfMap["column_name"].(string)),
+				NewColumnName: aws.String(tfMap["new_column_name"].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenDataSetPhysicalTableMap(apiObjects map[string]*quicksight.PhysicalTable) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"physical_table_map_id": k,
+		}
+
+		if v := apiObject.CustomSql; v != nil {
+			tfMap["custom_sql"] = []interface{}{map[string]interface{}{
+				"columns":         flattenDataSetInputColumns(v.Columns),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"name":            aws.StringValue(v.Name),
+				"sql_query":       aws.StringValue(v.SqlQuery),
+			}}
+		}
+
+		if v := apiObject.RelationalTable; v != nil {
+			tfMap["relational_table"] = []interface{}{map[string]interface{}{
+				"catalog":         aws.StringValue(v.Catalog),
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenDataSetInputColumns(v.InputColumns),
+				"name":            aws.StringValue(v.Name),
+				"schema":          aws.StringValue(v.Schema),
+			}}
+		}
+
+		if v := apiObject.S3Source; v != nil {
+			tfMapS3Source := map[string]interface{}{
+				"data_source_arn": aws.StringValue(v.DataSourceArn),
+				"input_columns":   flattenDataSetInputColumns(v.InputColumns),
+			}
+
+			if v := v.UploadSettings; v != nil {
+				tfMapS3Source["upload_settings"] = []interface{}{map[string]interface{}{
+					"contains_header": aws.BoolValue(v.ContainsHeader),
+					"delimiter":       aws.StringValue(v.Delimiter),
+					"format":          aws.StringValue(v.Format),
+					"start_from_row":  aws.Int64Value(v.StartFromRow),
+					"text_qualifier":  aws.StringValue(v.TextQualifier),
+				}}
+			}
+
+			tfMap["s3_source"] = []interface{}{tfMapS3Source}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataSetInputColumns(apiObjects []*quicksight.InputColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenDataSetLogicalTableMap(apiObjects map[string]*quicksight.LogicalTable) []interface{} {
+	var tfList []interface{}
+
+	for k, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"alias":                aws.StringValue(apiObject.Alias),
+			"data_transforms":      flattenDataSetDataTransforms(apiObject.DataTransforms),
+			"logical_table_map_id": k,
+		}
+
+		if v := apiObject.Source; v != nil {
+			tfMapSource := map[string]interface{}{
+				"data_set_arn":      aws.StringValue(v.DataSetArn),
+				"physical_table_id": aws.StringValue(v.PhysicalTableId),
+			}
+
+			if v := v.JoinInstruction; v != nil {
+				tfMapSource["join_instruction"] = []interface{}{map[string]interface{}{
+					"left_operand":  aws.StringValue(v.LeftOperand),
+					"on_clause":     aws.StringValue(v.OnClause),
+					"right_operand": aws.StringValue(v.RightOperand),
+					"type":          aws.StringValue(v.Type),
+				}}
+			}
+
+			tfMap["source"] = []interface{}{tfMapSource}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataSetDataTransforms(apiObjects []*quicksight.TransformOperation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.CastColumnTypeOperation; v != nil {
+			tfMap["cast_column_type_operation"] = []interface{}{map[string]interface{}{
+				"column_name":     aws.StringValue(v.ColumnName),
+				"format":          aws.StringValue(v.Format),
+				"new_column_type": aws.StringValue(v.NewColumnType),
+			}}
+		}
+
+		if v := apiObject.CreateColumnsOperation; v != nil {
+			var tfListColumns []interface{}
+
+			for _, v := range v.Columns {
+				if v == nil {
+					continue
+				}
+
+				tfListColumns = append(tfListColumns, map[string]interface{}{
+					"column_id":   aws.StringValue(v.ColumnId),
+					"column_name": aws.StringValue(v.ColumnName),
+					"expression":  aws.StringValue(v.Expression),
+				})
+			}
+
+			tfMap["create_columns_operation"] = []interface{}{map[string]interface{}{
+				"columns": tfListColumns,
+			}}
+		}
+
+		if v := apiObject.FilterOperation; v != nil {
+			tfMap["filter_operation"] = []interface{}{map[string]interface{}{
+				"condition_expression": aws.StringValue(v.ConditionExpression),
+			}}
+		}
+
+		if v := apiObject.ProjectOperation; v != nil {
+			tfMap["project_operation"] = []interface{}{map[string]interface{}{
+				"projected_columns": aws.StringValueSlice(v.ProjectedColumns),
+			}}
+		}
+
+		if v := apiObject.RenameColumnOperation; v != nil {
+			tfMap["rename_column_operation"] = []interface{}{map[string]interface{}{
+				"column_name":     aws.StringValue(v.ColumnName),
+				"new_column_name": aws.StringValue(v.NewColumnName),
+			}}
+		}
+
+		// Transforms not supported by this resource (e.g. tag_column_operation) are dropped.
+		if len(tfMap) == 0 {
+			continue
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataSetOutputColumns(apiObjects []*quicksight.OutputColumn) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+			"type":        aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func ParseDataSetID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SET_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
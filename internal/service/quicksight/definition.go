@@ -0,0 +1,129 @@
+package quicksight
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// definitionSchema returns the schema for an analysis, dashboard or template definition.
+// The definition is expressed as JSON in the QuickSight API format rather than as nested blocks.
+// The definition read back from QuickSight includes defaults for the fields that aren't configured,
+// so only the configured fields are compared.
+func definitionSchema(exactlyOneOf ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ExactlyOneOf:     exactlyOneOf,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: suppressDefinitionDefaults,
+		StateFunc: func(v interface{}) string {
+			json, _ := structure.NormalizeJsonString(v)
+			return json
+		},
+	}
+}
+
+// expandDefinition unmarshals a JSON definition into the specified API object.
+func expandDefinition(s string, apiObject interface{}) error {
+	return jsonutil.UnmarshalJSON(apiObject, strings.NewReader(s))
+}
+
+// flattenDefinition marshals the specified API object to a JSON definition.
+func flattenDefinition(apiObject interface{}) (string, error) {
+	b, err := jsonutil.BuildJSON(apiObject)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+// suppressDefinitionDefaults suppresses the difference between a configured definition and the definition in state
+// when every field set in configuration has the same value in state.
+// Fields that are only in state are the defaults QuickSight fills in.
+func suppressDefinitionDefaults(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+
+	var o, n interface{}
+
+	if err := json.Unmarshal([]byte(old), &o); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(new), &n); err != nil {
+		return false
+	}
+
+	return definitionContains(o, n)
+}
+
+// definitionContains returns whether the JSON value old contains every field set in new with the same value.
+// Array elements are compared in order, so old and new arrays must have the same length.
+func definitionContains(old, new interface{}) bool {
+	switch new := new.(type) {
+	case map[string]interface{}:
+		old, ok := old.(map[string]interface{})
+
+		if !ok {
+			return false
+		}
+
+		for k, v := range new {
+			ov, ok := old[k]
+
+			if !ok {
+				// An empty value may be omitted from the definition read back.
+				if definitionValueEmpty(v) {
+					continue
+				}
+
+				return false
+			}
+
+			if !definitionContains(ov, v) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		old, ok := old.([]interface{})
+
+		if !ok || len(old) != len(new) {
+			return false
+		}
+
+		for i := range new {
+			if !definitionContains(old[i], new[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(old, new)
+	}
+}
+
+func definitionValueEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,77 @@
+package quicksight
+
+import (
+	"testing"
+)
+
+func TestSuppressDefinitionDefaults(t *testing.T) {
+	t.Parallel()
+
+	state := `{
+  "DataSetIdentifierDeclarations": [{"DataSetArn": "arn", "Identifier": "1"}],
+  "Options": {"WeekStart": "SUNDAY"},
+  "Sheets": [{"ContentType": "INTERACTIVE", "Name": "Test1", "SheetId": "Test1", "Visuals": []}]
+}`
+
+	testCases := []struct {
+		name     string
+		old      string
+		new      string
+		expected bool
+	}{
+		{
+			name:     "defaults only in state",
+			old:      state,
+			new:      `{"DataSetIdentifierDeclarations": [{"Identifier": "1", "DataSetArn": "arn"}], "Sheets": [{"SheetId": "Test1", "Name": "Test1"}]}`,
+			expected: true,
+		},
+		{
+			name:     "configured default",
+			old:      state,
+			new:      `{"DataSetIdentifierDeclarations": [{"Identifier": "1", "DataSetArn": "arn"}], "Options": {"WeekStart": "SUNDAY"}, "Sheets": [{"SheetId": "Test1", "Name": "Test1"}]}`,
+			expected: true,
+		},
+		{
+			name:     "empty value omitted from state",
+			old:      `{"Sheets": [{"SheetId": "Test1"}]}`,
+			new:      `{"FilterGroups": [], "Sheets": [{"SheetId": "Test1"}]}`,
+			expected: true,
+		},
+		{
+			name:     "changed value",
+			old:      state,
+			new:      `{"DataSetIdentifierDeclarations": [{"Identifier": "1", "DataSetArn": "arn"}], "Sheets": [{"SheetId": "Test1", "Name": "Test2"}]}`,
+			expected: false,
+		},
+		{
+			name:     "changed default",
+			old:      state,
+			new:      `{"DataSetIdentifierDeclarations": [{"Identifier": "1", "DataSetArn": "arn"}], "Options": {"WeekStart": "MONDAY"}, "Sheets": [{"SheetId": "Test1", "Name": "Test1"}]}`,
+			expected: false,
+		},
+		{
+			name:     "added element",
+			old:      state,
+			new:      `{"DataSetIdentifierDeclarations": [{"Identifier": "1", "DataSetArn": "arn"}], "Sheets": [{"SheetId": "Test1", "Name": "Test1"}, {"SheetId": "Test2"}]}`,
+			expected: false,
+		},
+		{
+			name:     "added field",
+			old:      state,
+			new:      `{"DataSetIdentifierDeclarations": [{"Identifier": "1", "DataSetArn": "arn"}], "Sheets": [{"SheetId": "Test1", "Name": "Test1", "Title": {"Visibility": "VISIBLE"}}]}`,
+			expected: false,
+		},
+		{
+			name:     "no state",
+			old:      "",
+			new:      `{"Sheets": []}`,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := suppressDefinitionDefaults("definition", testCase.old, testCase.new, nil); got != testCase.expected {
+			t.Errorf("%s: got %t, expected %t", testCase.name, got, testCase.expected)
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindGroupMembership(ctx context.Context, conn *quicksight.QuickSight, listInput *quicksight.ListGroupMembershipsInput, userName string) (bool, error) {
@@ -32,3 +35,228 @@ func FindGroupMembership(ctx context.Context, conn *quicksight.QuickSight, listI
 
 	return found, nil
 }
+
+func FindDataSetByTwoPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSetID string) (*quicksight.DataSet, error) {
+	input := &quicksight.DescribeDataSetInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+	}
+
+	output, err := conn.DescribeDataSetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DataSet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DataSet, nil
+}
+
+func FindRefreshScheduleByThreePartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dataSetID, scheduleID string) (*quicksight.RefreshSchedule, error) {
+	input := &quicksight.DescribeRefreshScheduleInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DataSetId:    aws.String(dataSetID),
+		ScheduleId:   aws.String(scheduleID),
+	}
+
+	output, err := conn.DescribeRefreshScheduleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RefreshSchedule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RefreshSchedule, nil
+}
+
+func FindTemplateByTwoPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string) (*quicksight.Template, error) {
+	input := &quicksight.DescribeTemplateInput{
+		AwsAccountId: aws.String(awsAccountID),
+		TemplateId:   aws.String(templateID),
+	}
+
+	output, err := conn.DescribeTemplateWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Template == nil || output.Template.Version == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Template, nil
+}
+
+func findTemplateDefinitionByTwoPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, versionNumber int64) (*quicksight.TemplateVersionDefinition, error) {
+	input := &quicksight.DescribeTemplateDefinitionInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		TemplateId:    aws.String(templateID),
+		VersionNumber: aws.Int64(versionNumber),
+	}
+
+	output, err := conn.DescribeTemplateDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+func FindAnalysisByTwoPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string) (*quicksight.Analysis, error) {
+	input := &quicksight.DescribeAnalysisInput{
+		AnalysisId:   aws.String(analysisID),
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAnalysisWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Analysis == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted analyses are retained for the recovery window.
+	if status := aws.StringValue(output.Analysis.Status); status == quicksight.ResourceStatusDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output.Analysis, nil
+}
+
+func findAnalysisDefinitionByTwoPartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string) (*quicksight.AnalysisDefinition, error) {
+	input := &quicksight.DescribeAnalysisDefinitionInput{
+		AnalysisId:   aws.String(analysisID),
+		AwsAccountId: aws.String(awsAccountID),
+	}
+
+	output, err := conn.DescribeAnalysisDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Definition, nil
+}
+
+// FindDashboardByThreePartKey returns the specified dashboard version.
+// The latest version is returned if versionNumber is zero.
+func FindDashboardByThreePartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64) (*quicksight.Dashboard, error) {
+	input := &quicksight.DescribeDashboardInput{
+		AwsAccountId: aws.String(awsAccountID),
+		DashboardId:  aws.String(dashboardID),
+	}
+
+	if versionNumber != 0 {
+		input.VersionNumber = aws.Int64(versionNumber)
+	}
+
+	output, err := conn.DescribeDashboardWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Dashboard == nil || output.Dashboard.Version == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Dashboard, nil
+}
+
+func findDashboardDefinitionByThreePartKey(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64) (*quicksight.DescribeDashboardDefinitionOutput, error) {
+	input := &quicksight.DescribeDashboardDefinitionInput{
+		AwsAccountId:  aws.String(awsAccountID),
+		DashboardId:   aws.String(dashboardID),
+		VersionNumber: aws.Int64(versionNumber),
+	}
+
+	output, err := conn.DescribeDashboardDefinitionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Definition == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,322 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRefreshSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRefreshScheduleCreate,
+		ReadWithoutTimeout:   resourceRefreshScheduleRead,
+		UpdateWithoutTimeout: resourceRefreshScheduleUpdate,
+		DeleteWithoutTimeout: resourceRefreshScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"data_set_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"refresh_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(quicksight.IngestionType_Values(), false),
+						},
+						"schedule_frequency": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"interval": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(quicksight.RefreshInterval_Values(), false),
+									},
+									"refresh_on_day": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"day_of_month": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"day_of_week": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(quicksight.DayOfWeek_Values(), false),
+												},
+											},
+										},
+									},
+									"time_of_the_day": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"timezone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"start_after_date_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+					},
+				},
+			},
+
+			"schedule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRefreshScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	dataSetId := d.Get("data_set_id").(string)
+	scheduleId := d.Get("schedule_id").(string)
+	id := fmt.Sprintf("%s/%s/%s", awsAccountId, dataSetId, scheduleId)
+
+	input := &quicksight.CreateRefreshScheduleInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+		Schedule:     expandRefreshSchedule(scheduleId, d.Get("schedule").([]interface{})),
+	}
+
+	_, err := conn.CreateRefreshScheduleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QuickSight Refresh Schedule (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceRefreshScheduleRead(ctx, d, meta)
+}
+
+func resourceRefreshScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dataSetId, scheduleId, err := ParseRefreshScheduleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedule, err := FindRefreshScheduleByThreePartKey(ctx, conn, awsAccountId, dataSetId, scheduleId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Refresh Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Refresh Schedule (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", schedule.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("data_set_id", dataSetId)
+	if err := d.Set("schedule", flattenRefreshSchedule(schedule)); err != nil {
+		return diag.Errorf("setting schedule: %s", err)
+	}
+	d.Set("schedule_id", schedule.ScheduleId)
+
+	return nil
+}
+
+func resourceRefreshScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dataSetId, scheduleId, err := ParseRefreshScheduleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &quicksight.UpdateRefreshScheduleInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+		Schedule:     expandRefreshSchedule(scheduleId, d.Get("schedule").([]interface{})),
+	}
+
+	_, err = conn.UpdateRefreshScheduleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("updating QuickSight Refresh Schedule (%s): %s", d.Id(), err)
+	}
+
+	return resourceRefreshScheduleRead(ctx, d, meta)
+}
+
+func resourceRefreshScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, dataSetId, scheduleId, err := ParseRefreshScheduleID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Refresh Schedule: %s", d.Id())
+	_, err = conn.DeleteRefreshScheduleWithContext(ctx, &quicksight.DeleteRefreshScheduleInput{
+		AwsAccountId: aws.String(awsAccountId),
+		DataSetId:    aws.String(dataSetId),
+		ScheduleId:   aws.String(scheduleId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting QuickSight Refresh Schedule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandRefreshSchedule(scheduleID string, tfList []interface{}) *quicksight.RefreshSchedule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &quicksight.RefreshSchedule{
+		RefreshType: aws.String(tfMap["refresh_type"].(string)),
+		ScheduleId:  aws.String(scheduleID),
+	}
+
+	if v, ok := tfMap["schedule_frequency"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.ScheduleFrequency = &quicksight.RefreshFrequency{
+			Interval: aws.String(tfMap["interval"].(string)),
+		}
+
+		if v, ok := tfMap["refresh_on_day"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			refreshOnDay := &quicksight.ScheduleRefreshOnEntity{}
+
+			if v, ok := tfMap["day_of_month"].(string); ok && v != "" {
+				refreshOnDay.DayOfMonth = aws.String(v)
+			}
+
+			if v, ok := tfMap["day_of_week"].(string); ok && v != "" {
+				refreshOnDay.DayOfWeek = aws.String(v)
+			}
+
+			apiObject.ScheduleFrequency.RefreshOnDay = refreshOnDay
+		}
+
+		if v, ok := tfMap["time_of_the_day"].(string); ok && v != "" {
+			apiObject.ScheduleFrequency.TimeOfTheDay = aws.String(v)
+		}
+
+		if v, ok := tfMap["timezone"].(string); ok && v != "" {
+			apiObject.ScheduleFrequency.Timezone = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["start_after_date_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.StartAfterDateTime = aws.Time(t)
+	}
+
+	return apiObject
+}
+
+func flattenRefreshSchedule(apiObject *quicksight.RefreshSchedule) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"refresh_type": aws.StringValue(apiObject.RefreshType),
+	}
+
+	if v := apiObject.ScheduleFrequency; v != nil {
+		tfMapFrequency := map[string]interface{}{
+			"interval":        aws.StringValue(v.Interval),
+			"time_of_the_day": aws.StringValue(v.TimeOfTheDay),
+			"timezone":        aws.StringValue(v.Timezone),
+		}
+
+		if v := v.RefreshOnDay; v != nil {
+			tfMapFrequency["refresh_on_day"] = []interface{}{map[string]interface{}{
+				"day_of_month": aws.StringValue(v.DayOfMonth),
+				"day_of_week":  aws.StringValue(v.DayOfWeek),
+			}}
+		}
+
+		tfMap["schedule_frequency"] = []interface{}{tfMapFrequency}
+	}
+
+	if v := apiObject.StartAfterDateTime; v != nil {
+		tfMap["start_after_date_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func ParseRefreshScheduleID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/DATA_SET_ID/SCHEDULE_ID", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -16,10 +16,204 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_quicksight_analysis", &resource.Sweeper{
+		Name: "aws_quicksight_analysis",
+		F:    sweepAnalyses,
+	})
+
+	resource.AddTestSweepers("aws_quicksight_dashboard", &resource.Sweeper{
+		Name: "aws_quicksight_dashboard",
+		F:    sweepDashboards,
+	})
+
+	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
+		Name: "aws_quicksight_data_set",
+		F:    sweepDataSets,
+		Dependencies: []string{
+			"aws_quicksight_analysis",
+			"aws_quicksight_dashboard",
+			"aws_quicksight_template",
+		},
+	})
+
 	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepsDataSource,
+		Dependencies: []string{
+			"aws_quicksight_data_set",
+		},
+	})
+
+	resource.AddTestSweepers("aws_quicksight_template", &resource.Sweeper{
+		Name: "aws_quicksight_template",
+		F:    sweepTemplates,
+		Dependencies: []string{
+			"aws_quicksight_dashboard",
+		},
+	})
+}
+
+func sweepAnalyses(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).QuickSightConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	awsAccountId := client.(*conns.AWSClient).AccountID
+
+	input := &quicksight.ListAnalysesInput{
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	err = conn.ListAnalysesPagesWithContext(ctx, input, func(page *quicksight.ListAnalysesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AnalysisSummaryList {
+			if v == nil || aws.StringValue(v.Status) == quicksight.ResourceStatusDeleted {
+				continue
+			}
+
+			r := ResourceAnalysis()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(v.AnalysisId)))
+			d.Set("recovery_window_in_days", 0)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Analyses: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Analyses for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Analysis sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDashboards(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).QuickSightConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	awsAccountId := client.(*conns.AWSClient).AccountID
+
+	input := &quicksight.ListDashboardsInput{
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	err = conn.ListDashboardsPagesWithContext(ctx, input, func(page *quicksight.ListDashboardsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DashboardSummaryList {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceDashboard()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(v.DashboardId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Dashboards: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Dashboards for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Dashboard sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDataSets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).QuickSightConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	awsAccountId := client.(*conns.AWSClient).AccountID
+
+	input := &quicksight.ListDataSetsInput{
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	err = conn.ListDataSetsPagesWithContext(ctx, input, func(page *quicksight.ListDataSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataSetSummaries {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceDataSet()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(v.DataSetId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
 	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Data Sets: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Data Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Data Set sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepsDataSource(region string) error {
@@ -77,3 +271,57 @@ func sweepsDataSource(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepTemplates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).QuickSightConn()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	awsAccountId := client.(*conns.AWSClient).AccountID
+
+	input := &quicksight.ListTemplatesInput{
+		AwsAccountId: aws.String(awsAccountId),
+	}
+
+	err = conn.ListTemplatesPagesWithContext(ctx, input, func(page *quicksight.ListTemplatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TemplateSummaryList {
+			if v == nil {
+				continue
+			}
+
+			r := ResourceTemplate()
+			d := r.Data(nil)
+			d.SetId(fmt.Sprintf("%s/%s", awsAccountId, aws.StringValue(v.TemplateId)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QuickSight Templates: %w", err))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QuickSight Templates for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QuickSight Template sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
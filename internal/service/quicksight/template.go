@@ -0,0 +1,451 @@
+package quicksight
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTemplateCreate,
+		ReadWithoutTimeout:   resourceTemplateRead,
+		UpdateWithoutTimeout: resourceTemplateUpdate,
+		DeleteWithoutTimeout: resourceTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"definition": definitionSchema("definition", "source_entity"),
+
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+
+			"permission": permissionSchema(),
+
+			"source_entity": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"definition", "source_entity"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_analysis": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"data_set_references": dataSetReferencesSchema(),
+								},
+							},
+						},
+						"source_template": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"source_entity_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tftags.TagsSchema(),
+
+			"tags_all": tftags.TagsSchemaComputed(),
+
+			"template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func dataSetReferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"data_set_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"data_set_placeholder": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	awsAccountId := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("aws_account_id"); ok {
+		awsAccountId = v.(string)
+	}
+	templateId := d.Get("template_id").(string)
+	id := fmt.Sprintf("%s/%s", awsAccountId, templateId)
+
+	input := &quicksight.CreateTemplateInput{
+		AwsAccountId:       aws.String(awsAccountId),
+		Name:               aws.String(d.Get("name").(string)),
+		TemplateId:         aws.String(templateId),
+		VersionDescription: aws.String(d.Get("version_description").(string)),
+	}
+
+	if v, ok := d.GetOk("definition"); ok {
+		input.Definition = &quicksight.TemplateVersionDefinition{}
+
+		if err := expandDefinition(v.(string), input.Definition); err != nil {
+			return diag.Errorf("creating QuickSight Template (%s): %s", id, err)
+		}
+	}
+
+	if v, ok := d.GetOk("permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = expandDataSourcePermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceEntity = expandTemplateSourceEntity(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.CreateTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating QuickSight Template (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitTemplateCreated(ctx, conn, awsAccountId, templateId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for QuickSight Template (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	awsAccountId, templateId, err := ParseTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, err := FindTemplateByTwoPartKey(ctx, conn, awsAccountId, templateId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] QuickSight Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", template.Arn)
+	d.Set("aws_account_id", awsAccountId)
+	d.Set("created_time", aws.TimeValue(template.CreatedTime).Format(time.RFC3339))
+	d.Set("last_updated_time", aws.TimeValue(template.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", template.Name)
+	d.Set("source_entity_arn", template.Version.SourceEntityArn)
+	d.Set("status", template.Version.Status)
+	d.Set("template_id", template.TemplateId)
+	d.Set("version_description", template.Version.Description)
+	d.Set("version_number", template.Version.VersionNumber)
+
+	definition, err := findTemplateDefinitionByTwoPartKey(ctx, conn, awsAccountId, templateId, aws.Int64Value(template.Version.VersionNumber))
+
+	if err != nil {
+		return diag.Errorf("reading QuickSight Template (%s) definition: %s", d.Id(), err)
+	}
+
+	v, err := flattenDefinition(definition)
+
+	if err != nil {
+		return diag.Errorf("setting definition: %s", err)
+	}
+
+	d.Set("definition", v)
+
+	tags, err := ListTags(ctx, conn, d.Get("arn").(string))
+
+	if err != nil {
+		return diag.Errorf("listing tags for QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("setting tags_all: %s", err)
+	}
+
+	permsResp, err := conn.DescribeTemplatePermissionsWithContext(ctx, &quicksight.DescribeTemplatePermissionsInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TemplateId:   aws.String(templateId),
+	})
+
+	if err != nil {
+		return diag.Errorf("describing QuickSight Template (%s) Permissions: %s", d.Id(), err)
+	}
+
+	if err := d.Set("permission", flattenPermissions(permsResp.Permissions)); err != nil {
+		return diag.Errorf("setting permission: %s", err)
+	}
+
+	return nil
+}
+
+func resourceTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, templateId, err := ParseTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChangesExcept("permission", "tags", "tags_all") {
+		input := &quicksight.UpdateTemplateInput{
+			AwsAccountId:       aws.String(awsAccountId),
+			Name:               aws.String(d.Get("name").(string)),
+			TemplateId:         aws.String(templateId),
+			VersionDescription: aws.String(d.Get("version_description").(string)),
+		}
+
+		// Each update creates a new template version from either the source entity or the definition.
+		if v, ok := d.GetOk("source_entity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SourceEntity = expandTemplateSourceEntity(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Definition = &quicksight.TemplateVersionDefinition{}
+
+			if err := expandDefinition(d.Get("definition").(string), input.Definition); err != nil {
+				return diag.Errorf("updating QuickSight Template (%s): %s", d.Id(), err)
+			}
+		}
+
+		_, err = conn.UpdateTemplateWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Template (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitTemplateUpdated(ctx, conn, awsAccountId, templateId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("waiting for QuickSight Template (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("permission") {
+		o, n := d.GetChange("permission")
+		toGrant, toRevoke := DiffPermissions(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		input := &quicksight.UpdateTemplatePermissionsInput{
+			AwsAccountId: aws.String(awsAccountId),
+			TemplateId:   aws.String(templateId),
+		}
+
+		if len(toGrant) > 0 {
+			input.GrantPermissions = toGrant
+		}
+
+		if len(toRevoke) > 0 {
+			input.RevokePermissions = toRevoke
+		}
+
+		_, err = conn.UpdateTemplatePermissionsWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating QuickSight Template (%s) permissions: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("updating QuickSight Template (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTemplateRead(ctx, d, meta)
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).QuickSightConn()
+
+	awsAccountId, templateId, err := ParseTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting QuickSight Template: %s", d.Id())
+	_, err = conn.DeleteTemplateWithContext(ctx, &quicksight.DeleteTemplateInput{
+		AwsAccountId: aws.String(awsAccountId),
+		TemplateId:   aws.String(templateId),
+	})
+
+	if tfawserr.ErrCodeEquals(err, quicksight.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting QuickSight Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTemplateSourceEntity(tfMap map[string]interface{}) *quicksight.TemplateSourceEntity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &quicksight.TemplateSourceEntity{}
+
+	if v, ok := tfMap["source_analysis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SourceAnalysis = &quicksight.TemplateSourceAnalysis{
+			Arn:               aws.String(tfMap["arn"].(string)),
+			DataSetReferences: expandDataSetReferences(tfMap["data_set_references"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["source_template"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.SourceTemplate = &quicksight.TemplateSourceTemplate{
+			Arn: aws.String(tfMap["arn"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandDataSetReferences(tfList []interface{}) []*quicksight.DataSetReference {
+	var apiObjects []*quicksight.DataSetReference
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &quicksight.DataSetReference{
+			DataSetArn:         aws.String(tfMap["data_set_arn"].(string)),
+			DataSetPlaceholder: aws.String(tfMap["data_set_placeholder"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func ParseTemplateID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected AWS_ACCOUNT_ID/TEMPLATE_ID", id)
+	}
+	return parts[0], parts[1], nil
+}
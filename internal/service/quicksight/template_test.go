@@ -0,0 +1,228 @@
+package quicksight_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfquicksight "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccQuickSightTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template quicksight.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rId, rName, "Test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "quicksight", fmt.Sprintf("template/%s", rId)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "template_id", rId),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Test1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "1"),
+				),
+			},
+			{
+				// The definition read back includes defaults for the fields that aren't configured.
+				Config:             testAccTemplateConfig_basic(rId, rName, "Test1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateConfig_basic(rId, rName, "Test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "Test2"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template quicksight.Template
+	resourceName := "aws_quicksight_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_basic(rId, rName, "Test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfquicksight.ResourceTemplate(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQuickSightTemplate_sourceEntity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template quicksight.Template
+	resourceName := "aws_quicksight_template.copy"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rId := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, quicksight.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateConfig_sourceEntity(rId, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrPair(resourceName, "source_entity_arn", "aws_quicksight_template.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", quicksight.ResourceStatusCreationSuccessful),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_entity"},
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateExists(ctx context.Context, n string, v *quicksight.Template) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No QuickSight Template ID is set")
+		}
+
+		awsAccountID, templateID, err := tfquicksight.ParseTemplateID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
+
+		output, err := tfquicksight.FindTemplateByTwoPartKey(ctx, conn, awsAccountID, templateID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QuickSightConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_quicksight_template" {
+				continue
+			}
+
+			awsAccountID, templateID, err := tfquicksight.ParseTemplateID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfquicksight.FindTemplateByTwoPartKey(ctx, conn, awsAccountID, templateID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("QuickSight Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTemplateConfig_basic(rId, rName, versionDescription string) string {
+	return acctest.ConfigCompose(
+		testAccDataSetConfig_basic(rId, rName),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "test" {
+  template_id         = %[1]q
+  name                = %[2]q
+  version_description = %[3]q
+
+  definition = jsonencode({
+    DataSetConfigurations = [{
+      Placeholder = "1"
+      DataSetSchema = {
+        ColumnSchemaList = [{
+          Name     = "Column1"
+          DataType = "STRING"
+        }]
+      }
+      ColumnGroupSchemaList = []
+    }]
+    Sheets = [{
+      SheetId = "Test1"
+      Name    = "Test1"
+    }]
+  })
+}
+`, rId, rName, versionDescription))
+}
+
+func testAccTemplateConfig_sourceEntity(rId, rName string) string {
+	return acctest.ConfigCompose(
+		testAccTemplateConfig_basic(rId, rName, "Test1"),
+		fmt.Sprintf(`
+resource "aws_quicksight_template" "copy" {
+  template_id         = "%[1]s-copy"
+  name                = "%[2]s-copy"
+  version_description = "Test1"
+
+  source_entity {
+    source_template {
+      arn = aws_quicksight_template.test.arn
+    }
+  }
+}
+`, rId, rName))
+}
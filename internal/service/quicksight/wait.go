@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -59,3 +60,179 @@ func waitUpdated(ctx context.Context, conn *quicksight.QuickSight, accountId, da
 
 	return nil, err
 }
+
+// waitTemplateCreated waits for a template to return CREATION_SUCCESSFUL
+func waitTemplateCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, timeout time.Duration) (*quicksight.Template, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusTemplate(ctx, conn, awsAccountID, templateID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Template); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed {
+			tfresource.SetLastError(err, templateErrors(output.Version.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitTemplateUpdated waits for a template to return UPDATE_SUCCESSFUL.
+// Updating a template creates a new version, so creation statuses are accepted too.
+func waitTemplateUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, templateID string, timeout time.Duration) (*quicksight.Template, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress, quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful, quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: statusTemplate(ctx, conn, awsAccountID, templateID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Template); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed || status == quicksight.ResourceStatusUpdateFailed {
+			tfresource.SetLastError(err, templateErrors(output.Version.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitAnalysisCreated waits for an analysis to return CREATION_SUCCESSFUL
+func waitAnalysisCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string, timeout time.Duration) (*quicksight.Analysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusAnalysis(ctx, conn, awsAccountID, analysisID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Analysis); ok {
+		if status := aws.StringValue(output.Status); status == quicksight.ResourceStatusCreationFailed {
+			tfresource.SetLastError(err, analysisErrors(output.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitAnalysisUpdated waits for an analysis to return UPDATE_SUCCESSFUL
+func waitAnalysisUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, analysisID string, timeout time.Duration) (*quicksight.Analysis, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: statusAnalysis(ctx, conn, awsAccountID, analysisID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Analysis); ok {
+		if status := aws.StringValue(output.Status); status == quicksight.ResourceStatusUpdateFailed {
+			tfresource.SetLastError(err, analysisErrors(output.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDashboardCreated waits for a dashboard version to return CREATION_SUCCESSFUL
+func waitDashboardCreated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64, timeout time.Duration) (*quicksight.Dashboard, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful},
+		Refresh: statusDashboard(ctx, conn, awsAccountID, dashboardID, versionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Dashboard); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed {
+			tfresource.SetLastError(err, dashboardErrors(output.Version.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitDashboardUpdated waits for a dashboard version to return UPDATE_SUCCESSFUL.
+// Updating a dashboard creates a new version, so creation statuses are accepted too.
+func waitDashboardUpdated(ctx context.Context, conn *quicksight.QuickSight, awsAccountID, dashboardID string, versionNumber int64, timeout time.Duration) (*quicksight.Dashboard, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{quicksight.ResourceStatusCreationInProgress, quicksight.ResourceStatusUpdateInProgress},
+		Target:  []string{quicksight.ResourceStatusCreationSuccessful, quicksight.ResourceStatusUpdateSuccessful},
+		Refresh: statusDashboard(ctx, conn, awsAccountID, dashboardID, versionNumber),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*quicksight.Dashboard); ok {
+		if status := aws.StringValue(output.Version.Status); status == quicksight.ResourceStatusCreationFailed || status == quicksight.ResourceStatusUpdateFailed {
+			tfresource.SetLastError(err, dashboardErrors(output.Version.Errors))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func templateErrors(apiObjects []*quicksight.TemplateError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Type), aws.StringValue(apiObject.Message)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func analysisErrors(apiObjects []*quicksight.AnalysisError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Type), aws.StringValue(apiObject.Message)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func dashboardErrors(apiObjects []*quicksight.DashboardError) error {
+	var errs *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Type), aws.StringValue(apiObject.Message)))
+	}
+
+	return errs.ErrorOrNil()
+}
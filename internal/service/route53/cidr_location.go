@@ -0,0 +1,218 @@
+package route53
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Maximum number of CIDR blocks in a single ChangeCidrCollection request.
+	cidrCollectionChangeMaxCIDRs = 1000
+
+	cidrCollectionChangeTimeout = 5 * time.Minute
+)
+
+func ResourceCIDRLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCIDRLocationCreate,
+		ReadWithoutTimeout:   resourceCIDRLocationRead,
+		UpdateWithoutTimeout: resourceCIDRLocationUpdate,
+		DeleteWithoutTimeout: resourceCIDRLocationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"cidr_collection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 16),
+					validation.StringDoesNotMatch(regexp.MustCompile(`^\*$`), `must not be "*"`),
+				),
+			},
+		},
+	}
+}
+
+func resourceCIDRLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	collectionID := d.Get("cidr_collection_id").(string)
+	name := d.Get("name").(string)
+	id := CIDRLocationCreateResourceID(collectionID, name)
+	cidrBlocks := flex.ExpandStringValueSet(d.Get("cidr_blocks").(*schema.Set))
+
+	if err := changeCIDRCollection(ctx, conn, collectionID, expandCIDRCollectionChanges(route53.CidrCollectionChangeActionPut, name, cidrBlocks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 CIDR Location (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCIDRLocationRead(ctx, d, meta)...)
+}
+
+func resourceCIDRLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	cidrBlocks, err := FindCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 CIDR Location (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	d.Set("cidr_blocks", cidrBlocks)
+	d.Set("cidr_collection_id", collectionID)
+	d.Set("name", name)
+
+	return diags
+}
+
+func resourceCIDRLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	o, n := d.GetChange("cidr_blocks")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+	// Additions go first so that the location is never left empty, which would delete it.
+	var changes []*route53.CidrCollectionChange
+	changes = append(changes, expandCIDRCollectionChanges(route53.CidrCollectionChangeActionPut, name, add)...)
+	changes = append(changes, expandCIDRCollectionChanges(route53.CidrCollectionChangeActionDeleteIfExists, name, del)...)
+
+	if err := changeCIDRCollection(ctx, conn, collectionID, changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCIDRLocationRead(ctx, d, meta)...)
+}
+
+func resourceCIDRLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	collectionID, name, err := CIDRLocationParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	cidrBlocks := flex.ExpandStringValueSet(d.Get("cidr_blocks").(*schema.Set))
+
+	log.Printf("[DEBUG] Deleting Route 53 CIDR Location: %s", d.Id())
+	err = changeCIDRCollection(ctx, conn, collectionID, expandCIDRCollectionChanges(route53.CidrCollectionChangeActionDeleteIfExists, name, cidrBlocks))
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 CIDR Location (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// expandCIDRCollectionChanges returns the CIDR collection changes for the specified action,
+// splitting the CIDR blocks so that no single change exceeds the API limit.
+func expandCIDRCollectionChanges(action, locationName string, cidrBlocks []string) []*route53.CidrCollectionChange {
+	var changes []*route53.CidrCollectionChange
+
+	for len(cidrBlocks) > 0 {
+		n := len(cidrBlocks)
+		if n > cidrCollectionChangeMaxCIDRs {
+			n = cidrCollectionChangeMaxCIDRs
+		}
+
+		changes = append(changes, &route53.CidrCollectionChange{
+			Action:       aws.String(action),
+			CidrList:     aws.StringSlice(cidrBlocks[:n]),
+			LocationName: aws.String(locationName),
+		})
+
+		cidrBlocks = cidrBlocks[n:]
+	}
+
+	return changes
+}
+
+// changeCIDRCollection applies the specified changes to a CIDR collection.
+// Changes are batched into as few requests as the API limits allow.
+func changeCIDRCollection(ctx context.Context, conn *route53.Route53, collectionID string, changes []*route53.CidrCollectionChange) error {
+	for len(changes) > 0 {
+		var batch []*route53.CidrCollectionChange
+		n := 0
+
+		for len(changes) > 0 && n+len(changes[0].CidrList) <= cidrCollectionChangeMaxCIDRs {
+			n += len(changes[0].CidrList)
+			batch = append(batch, changes[0])
+			changes = changes[1:]
+		}
+
+		input := &route53.ChangeCidrCollectionInput{
+			Changes: batch,
+			Id:      aws.String(collectionID),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, cidrCollectionChangeTimeout, func() (interface{}, error) {
+			return conn.ChangeCidrCollectionWithContext(ctx, input)
+		}, route53.ErrCodeConcurrentModification)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -120,11 +120,38 @@ func ResourceRecord() *schema.Resource {
 				},
 			},
 
+			"cidr_routing_policy": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ConflictsWith: []string{
+					"failover_routing_policy",
+					"geolocation_routing_policy",
+					"latency_routing_policy",
+					"multivalue_answer_routing_policy",
+					"weighted_routing_policy",
+				},
+				RequiredWith: []string{"set_identifier"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"location_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"failover_routing_policy": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -153,6 +180,7 @@ func ResourceRecord() *schema.Resource {
 				MaxItems: 1,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"weighted_routing_policy",
@@ -174,6 +202,7 @@ func ResourceRecord() *schema.Resource {
 				MaxItems: 1,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"latency_routing_policy",
 					"weighted_routing_policy",
@@ -203,6 +232,7 @@ func ResourceRecord() *schema.Resource {
 				MaxItems: 1,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -223,6 +253,7 @@ func ResourceRecord() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ConflictsWith: []string{
+					"cidr_routing_policy",
 					"failover_routing_policy",
 					"geolocation_routing_policy",
 					"latency_routing_policy",
@@ -290,12 +321,26 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	// If the old record has any of the following, we need to pass that in
 	// here because otherwise the API will give us an error:
+	// - cidr_routing_policy
 	// - failover_routing_policy
 	// - geolocation_routing_policy
 	// - latency_routing_policy
 	// - multivalue_answer_routing_policy
 	// - weighted_routing_policy
 
+	if v, _ := d.GetChange("cidr_routing_policy"); v != nil {
+		if o, ok := v.([]interface{}); ok {
+			if len(o) == 1 {
+				if v, ok := o[0].(map[string]interface{}); ok {
+					oldRec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+						CollectionId: aws.String(v["collection_id"].(string)),
+						LocationName: aws.String(v["location_name"].(string)),
+					}
+				}
+			}
+		}
+	}
+
 	if v, _ := d.GetChange("failover_routing_policy"); v != nil {
 		if o, ok := v.([]interface{}); ok {
 			if len(o) == 1 {
@@ -601,6 +646,16 @@ func resourceRecordRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.Set("ttl", record.TTL)
 
+	if record.CidrRoutingConfig != nil {
+		v := []map[string]interface{}{{
+			"collection_id": aws.StringValue(record.CidrRoutingConfig.CollectionId),
+			"location_name": aws.StringValue(record.CidrRoutingConfig.LocationName),
+		}}
+		if err := d.Set("cidr_routing_policy", v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cidr records for: %s, error: %s", d.Id(), err)
+		}
+	}
+
 	if record.Failover != nil {
 		v := []map[string]interface{}{{
 			"type": aws.StringValue(record.Failover),
@@ -857,6 +912,16 @@ func resourceRecordBuildSet(d *schema.ResourceData, zoneName string) *route53.Re
 		}
 	}
 
+	if v, ok := d.GetOk("cidr_routing_policy"); ok {
+		records := v.([]interface{})
+		cidr := records[0].(map[string]interface{})
+
+		rec.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(cidr["collection_id"].(string)),
+			LocationName: aws.String(cidr["location_name"].(string)),
+		}
+	}
+
 	if v, ok := d.GetOk("failover_routing_policy"); ok {
 		records := v.([]interface{})
 		failover := records[0].(map[string]interface{})
@@ -20,6 +20,19 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_route53_cidr_collection", &resource.Sweeper{
+		Name: "aws_route53_cidr_collection",
+		F:    sweepCIDRCollections,
+		Dependencies: []string{
+			"aws_route53_cidr_location",
+		},
+	})
+
+	resource.AddTestSweepers("aws_route53_cidr_location", &resource.Sweeper{
+		Name: "aws_route53_cidr_location",
+		F:    sweepCIDRLocations,
+	})
+
 	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
@@ -62,6 +75,122 @@ func init() {
 	})
 }
 
+func sweepCIDRCollections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).Route53Conn()
+	input := &route53.ListCidrCollectionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCidrCollectionsPagesWithContext(ctx, input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			r := ResourceCIDRCollection()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.Id))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 CIDR Collection sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Route 53 CIDR Collections (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Route 53 CIDR Collections (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepCIDRLocations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).Route53Conn()
+	input := &route53.ListCidrCollectionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCidrCollectionsPagesWithContext(ctx, input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			collectionID := aws.StringValue(v.Id)
+			input := &route53.ListCidrLocationsInput{
+				CollectionId: aws.String(collectionID),
+			}
+
+			err := conn.ListCidrLocationsPagesWithContext(ctx, input, func(page *route53.ListCidrLocationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.CidrLocations {
+					locationName := aws.StringValue(v.LocationName)
+					cidrBlocks, err := FindCIDRLocationByTwoPartKey(ctx, conn, collectionID, locationName)
+
+					if err != nil {
+						log.Printf("[WARN] Reading Route 53 CIDR Location (%s/%s): %s", collectionID, locationName, err)
+						continue
+					}
+
+					r := ResourceCIDRLocation()
+					d := r.Data(nil)
+					d.SetId(CIDRLocationCreateResourceID(collectionID, locationName))
+					d.Set("cidr_blocks", cidrBlocks)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				log.Printf("[WARN] Listing Route 53 CIDR Locations (%s): %s", collectionID, err)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Route 53 CIDR Location sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Route 53 CIDR Collections (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping Route 53 CIDR Locations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepHealthChecks(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
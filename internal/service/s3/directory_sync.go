@@ -0,0 +1,560 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sourcedir"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	directorySyncIDSeparator = ","
+
+	directorySyncDefaultUploadConcurrency = 10
+	// DeleteObjects deletes at most 1,000 objects per request.
+	directorySyncDeleteBatchSize = 1000
+)
+
+// ResourceDirectorySync has no Importer: the objects managed by the resource are those uploaded from
+// source_dir, which is only known from configuration, so the manifest can't be rebuilt from the bucket.
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: sourcedir.ValidPattern,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"content_types": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validDirectorySyncContentTypes,
+			},
+			"delete_removed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"excludes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: sourcedir.ValidPattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultUploadConcurrency,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := DirectorySyncCreateID(bucket, keyPrefix)
+
+	files, err := buildDirectorySyncFilesFromResourceData(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): reading source_dir: %s", id, err)
+	}
+
+	uploaded, err := uploadDirectorySyncFiles(ctx, conn, d, files)
+
+	if err != nil {
+		if len(uploaded) > 0 {
+			// Record the objects that were uploaded, so that they're deleted when the tainted resource is replaced.
+			d.SetId(id)
+			d.Set("manifest", uploaded)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("manifest", directorySyncManifest(files))
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+	manifest := flex.ExpandStringValueMap(d.Get("manifest").(map[string]interface{}))
+	objectETags, err := findDirectorySyncObjectETags(ctx, conn, bucket, d.Get("key_prefix").(string), manifest)
+
+	if !d.IsNewResource() && (tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket)) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Directory Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	etagsAreDigests, err := findDirectorySyncETagsAreDigests(ctx, conn, bucket)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects that have been removed or changed outside of Terraform are dropped from the manifest so that they're uploaded again.
+	for key, checksum := range manifest {
+		etag, ok := objectETags[key]
+
+		if !ok {
+			log.Printf("[WARN] S3 Directory Sync (%s) object (%s) not found, removing from manifest", d.Id(), key)
+			delete(manifest, key)
+			continue
+		}
+
+		if etagsAreDigests && !directorySyncObjectUnchanged(etag, checksum) {
+			log.Printf("[WARN] S3 Directory Sync (%s) object (%s) changed, removing from manifest", d.Id(), key)
+			delete(manifest, key)
+		}
+	}
+
+	d.Set("manifest", manifest)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	files, err := buildDirectorySyncFilesFromResourceData(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): reading source_dir: %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("manifest")
+	oldManifest := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	newManifest := directorySyncManifest(files)
+
+	// Object metadata isn't recorded in the manifest, so all objects are uploaded again when the rules that set it change.
+	uploadAll := d.HasChanges("cache_control", "content_types")
+	var uploads []*directorySyncFile
+
+	for _, file := range files {
+		if uploadAll || oldManifest[file.key] != file.checksum {
+			uploads = append(uploads, file)
+		}
+	}
+
+	uploaded, err := uploadDirectorySyncFiles(ctx, conn, d, uploads)
+
+	if err != nil {
+		// Record the objects that were uploaded. The files that failed keep their previous checksum, and the metadata rules
+		// keep their previous value, so that those files are uploaded again by the next apply.
+		for key, checksum := range uploaded {
+			oldManifest[key] = checksum
+		}
+
+		d.Set("manifest", oldManifest)
+
+		for _, key := range []string{"cache_control", "content_types"} {
+			o, _ := d.GetChange(key)
+			d.Set(key, o)
+		}
+
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("delete_removed").(bool) {
+		var removed []string
+
+		for key := range oldManifest {
+			if _, ok := newManifest[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+
+		if err := deleteDirectorySyncObjects(ctx, conn, d.Get("bucket").(string), removed); err != nil {
+			// Keep the removed objects in the manifest so that deleting them is retried by the next apply.
+			for _, key := range removed {
+				newManifest[key] = oldManifest[key]
+			}
+
+			d.Set("manifest", newManifest)
+
+			return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	d.Set("manifest", newManifest)
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	var keys []string
+
+	for key := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync: %s", d.Id())
+	err := deleteDirectorySyncObjects(ctx, conn, d.Get("bucket").(string), keys)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceDirectorySyncCustomizeDiff computes the manifest of the files in source_dir during plan,
+// so that the plan shows the objects that are added, changed or removed.
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"excludes", "key_prefix", "source_dir"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("manifest")
+		}
+	}
+
+	files, err := buildDirectorySyncFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+
+	// The directory may be created by another resource during apply.
+	if errors.Is(err, fs.ErrNotExist) {
+		return d.SetNewComputed("manifest")
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading source_dir: %w", err)
+	}
+
+	oldManifest := flex.ExpandStringValueMap(d.Get("manifest").(map[string]interface{}))
+	newManifest := directorySyncManifest(files)
+
+	if d.Id() != "" && directorySyncManifestsEqual(oldManifest, newManifest) {
+		return nil
+	}
+
+	return d.SetNew("manifest", newManifest)
+}
+
+func buildDirectorySyncFilesFromResourceData(d *schema.ResourceData) ([]*directorySyncFile, error) {
+	return buildDirectorySyncFiles(d.Get("source_dir").(string), d.Get("key_prefix").(string), flex.ExpandStringValueSet(d.Get("excludes").(*schema.Set)))
+}
+
+func directorySyncManifestsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || w != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// uploadDirectorySyncFiles uploads files, upload_concurrency files at a time, and returns the manifest of the files
+// that were uploaded, including when some uploads failed.
+// The Content-MD5 of each request is set from the file's checksum so that S3 verifies the uploaded data.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.S3, d *schema.ResourceData, files []*directorySyncFile) (map[string]string, error) {
+	bucket := d.Get("bucket").(string)
+	contentTypes := expandDirectorySyncContentTypeRules(flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{})))
+	rules := expandDirectorySyncCacheControlRules(d.Get("cache_control").([]interface{}))
+
+	uploaded := make(map[string]string, len(files))
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, d.Get("upload_concurrency").(int))
+
+	for _, file := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(file *directorySyncFile) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := uploadDirectorySyncFile(ctx, conn, bucket, file, contentTypes, rules)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, newObjectVersionError(file.key, "", err))
+				return
+			}
+
+			uploaded[file.key] = file.checksum
+		}(file)
+	}
+
+	wg.Wait()
+
+	return uploaded, errs.ErrorOrNil()
+}
+
+func uploadDirectorySyncFile(ctx context.Context, conn *s3.S3, bucket string, file *directorySyncFile, contentTypes []directorySyncContentTypeRule, rules []directorySyncCacheControlRule) error {
+	body, err := os.Open(file.path)
+
+	if err != nil {
+		return err
+	}
+
+	defer body.Close()
+
+	contentType, err := directorySyncContentType(file.name, contentTypes, body)
+
+	if err != nil {
+		return err
+	}
+
+	input := &s3.PutObjectInput{
+		Body:        body,
+		Bucket:      aws.String(bucket),
+		ContentMD5:  aws.String(file.checksum),
+		ContentType: aws.String(contentType),
+		Key:         aws.String(file.key),
+	}
+
+	cacheControl, err := directorySyncCacheControl(file.name, rules)
+
+	if err != nil {
+		return err
+	}
+
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Object: %s", file.key)
+	if _, err := conn.PutObjectWithContext(ctx, input); err != nil {
+		return fmt.Errorf("uploading: %w", err)
+	}
+
+	return nil
+}
+
+// deleteDirectorySyncObjects deletes the objects with the specified keys, directorySyncDeleteBatchSize at a time.
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.S3, bucket string, keys []string) error {
+	sort.Strings(keys)
+
+	for len(keys) > 0 {
+		n := directorySyncDeleteBatchSize
+		if len(keys) < n {
+			n = len(keys)
+		}
+
+		var objects []*s3.ObjectIdentifier
+
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		})
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		var deleteErrs *multierror.Error
+
+		for _, v := range output.Errors {
+			if aws.StringValue(v.Code) == s3.ErrCodeNoSuchKey {
+				continue
+			}
+
+			deleteErrs = multierror.Append(deleteErrs, newDeleteObjectVersionError(v))
+		}
+
+		if err := deleteErrs.ErrorOrNil(); err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+	}
+
+	return nil
+}
+
+// findDirectorySyncObjectETags returns the ETags of the objects with keys in manifest that exist in bucket.
+// The objects under prefix, which may be empty, are listed rather than looked up one at a time.
+func findDirectorySyncObjectETags(ctx context.Context, conn *s3.S3, bucket, prefix string, manifest map[string]string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	etags := make(map[string]string, len(manifest))
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			key := aws.StringValue(v.Key)
+
+			if _, ok := manifest[key]; ok {
+				etags[key] = strings.Trim(aws.StringValue(v.ETag), `"`)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return etags, nil
+}
+
+// findDirectorySyncETagsAreDigests returns whether the ETags of the objects uploaded to bucket are MD5 digests of their content.
+// Objects are uploaded with the bucket's default encryption, and the ETags of objects encrypted with SSE-KMS aren't digests.
+func findDirectorySyncETagsAreDigests(ctx context.Context, conn *s3.S3, bucket string) (bool, error) {
+	output, err := conn.GetBucketEncryptionWithContext(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeServerSideEncryptionConfigurationNotFound) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("reading S3 Bucket (%s) encryption configuration: %w", bucket, err)
+	}
+
+	if output.ServerSideEncryptionConfiguration == nil {
+		return true, nil
+	}
+
+	for _, v := range output.ServerSideEncryptionConfiguration.Rules {
+		if v == nil || v.ApplyServerSideEncryptionByDefault == nil {
+			continue
+		}
+
+		if strings.HasPrefix(aws.StringValue(v.ApplyServerSideEncryptionByDefault.SSEAlgorithm), s3.ServerSideEncryptionAwsKms) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func expandDirectorySyncCacheControlRules(tfList []interface{}) []directorySyncCacheControlRule {
+	var apiObjects []directorySyncCacheControlRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, directorySyncCacheControlRule{
+			pattern: tfMap["pattern"].(string),
+			value:   tfMap["value"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+func DirectorySyncCreateID(bucket, keyPrefix string) string {
+	if keyPrefix == "" {
+		return bucket
+	}
+
+	return strings.Join([]string{bucket, keyPrefix}, directorySyncIDSeparator)
+}
@@ -0,0 +1,257 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sourcedir"
+)
+
+const (
+	// Number of bytes http.DetectContentType considers when sniffing the content type of a file.
+	directorySyncSniffLen = 512
+)
+
+// directorySyncExtensionContentTypes maps lower-case file extensions to content types.
+// The system MIME type tables aren't consulted, so the same files get the same content types on every machine.
+var directorySyncExtensionContentTypes = map[string]string{
+	".avif":        "image/avif",
+	".bmp":         "image/bmp",
+	".css":         "text/css; charset=utf-8",
+	".csv":         "text/csv; charset=utf-8",
+	".eot":         "application/vnd.ms-fontobject",
+	".gif":         "image/gif",
+	".gz":          "application/gzip",
+	".htm":         "text/html; charset=utf-8",
+	".html":        "text/html; charset=utf-8",
+	".ico":         "image/vnd.microsoft.icon",
+	".jpeg":        "image/jpeg",
+	".jpg":         "image/jpeg",
+	".js":          "text/javascript; charset=utf-8",
+	".json":        "application/json",
+	".map":         "application/json",
+	".md":          "text/markdown; charset=utf-8",
+	".mjs":         "text/javascript; charset=utf-8",
+	".mp3":         "audio/mpeg",
+	".mp4":         "video/mp4",
+	".otf":         "font/otf",
+	".pdf":         "application/pdf",
+	".png":         "image/png",
+	".svg":         "image/svg+xml",
+	".tar":         "application/x-tar",
+	".ttf":         "font/ttf",
+	".txt":         "text/plain; charset=utf-8",
+	".wasm":        "application/wasm",
+	".webm":        "video/webm",
+	".webmanifest": "application/manifest+json",
+	".webp":        "image/webp",
+	".woff":        "font/woff",
+	".woff2":       "font/woff2",
+	".xml":         "text/xml; charset=utf-8",
+	".zip":         "application/zip",
+}
+
+// directorySyncFile is a local file synchronized to an S3 object.
+type directorySyncFile struct {
+	key  string
+	name string
+	path string
+	// Base64-encoded MD5 digest of the file's content, the same format as the Content-MD5 request header.
+	checksum string
+}
+
+// directorySyncCacheControlRule sets the Cache-Control header of objects whose name matches pattern.
+type directorySyncCacheControlRule struct {
+	pattern string
+	value   string
+}
+
+// buildDirectorySyncFiles returns the files in dir, in the order returned by sourcedir.Walk.
+// Each file's object key is its relative path prefixed with keyPrefix.
+// Files and directories matching any of the excludes glob patterns are skipped.
+func buildDirectorySyncFiles(dir, keyPrefix string, excludes []string) ([]*directorySyncFile, error) {
+	walked, err := sourcedir.Walk(dir, excludes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*directorySyncFile, 0, len(walked))
+
+	for _, v := range walked {
+		checksum, err := directorySyncFileChecksum(v.Path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &directorySyncFile{
+			key:      keyPrefix + v.Name,
+			name:     v.Name,
+			path:     v.Path,
+			checksum: checksum,
+		})
+	}
+
+	return files, nil
+}
+
+func directorySyncFileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	h := md5.New()
+
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// directorySyncObjectUnchanged returns whether an object with ETag etag still has the content uploaded from a file with
+// checksum. The ETag is compared with the hex-encoded MD5 digest of the file,
+// which is the ETag S3 reports for single-part uploads that aren't encrypted with SSE-KMS or SSE-C.
+func directorySyncObjectUnchanged(etag, checksum string) bool {
+	digest, err := base64.StdEncoding.DecodeString(checksum)
+
+	if err != nil {
+		return false
+	}
+
+	return etag == hex.EncodeToString(digest)
+}
+
+// directorySyncManifest returns the object key to checksum map stored in state for files.
+func directorySyncManifest(files []*directorySyncFile) map[string]string {
+	manifest := make(map[string]string, len(files))
+
+	for _, file := range files {
+		manifest[file.key] = file.checksum
+	}
+
+	return manifest
+}
+
+// directorySyncCacheControl returns the value of the first of the rules whose pattern matches name.
+func directorySyncCacheControl(name string, rules []directorySyncCacheControlRule) (string, error) {
+	for _, rule := range rules {
+		matched, err := sourcedir.Match(name, rule.pattern)
+
+		if err != nil {
+			return "", err
+		}
+
+		if matched {
+			return rule.value, nil
+		}
+	}
+
+	return "", nil
+}
+
+// directorySyncContentTypeRule sets the content type of objects whose name ends with suffix.
+type directorySyncContentTypeRule struct {
+	// Lower-case suffix with a leading dot, e.g. ".js" or ".min.js".
+	suffix      string
+	contentType string
+}
+
+// directorySyncContentTypeSuffix returns the normalized form of a content_types key: lower-case with a leading dot,
+// so that "js", ".js" and ".JS" are the same key.
+func directorySyncContentTypeSuffix(k string) string {
+	return "." + strings.TrimPrefix(strings.ToLower(k), ".")
+}
+
+// expandDirectorySyncContentTypeRules returns the content_types rules ordered longest suffix first, and then by suffix,
+// so that the most specific rule matching a file name is always the one used.
+func expandDirectorySyncContentTypeRules(contentTypes map[string]string) []directorySyncContentTypeRule {
+	rules := make([]directorySyncContentTypeRule, 0, len(contentTypes))
+
+	for k, v := range contentTypes {
+		rules = append(rules, directorySyncContentTypeRule{
+			suffix:      directorySyncContentTypeSuffix(k),
+			contentType: v,
+		})
+	}
+
+	sort.Slice(rules, func(i, j int) bool {
+		if a, b := len(rules[i].suffix), len(rules[j].suffix); a != b {
+			return a > b
+		}
+
+		return rules[i].suffix < rules[j].suffix
+	})
+
+	return rules
+}
+
+// validDirectorySyncContentTypes rejects content_types keys that are empty or the same once normalized.
+func validDirectorySyncContentTypes(v interface{}, k string) (ws []string, errors []error) {
+	seen := make(map[string]string)
+
+	for key := range v.(map[string]interface{}) {
+		suffix := directorySyncContentTypeSuffix(key)
+
+		if suffix == "." {
+			errors = append(errors, fmt.Errorf("%s: keys must not be empty", k))
+			continue
+		}
+
+		if other, ok := seen[suffix]; ok {
+			a, b := other, key
+			if b < a {
+				a, b = b, a
+			}
+			errors = append(errors, fmt.Errorf("%s: keys %q and %q are the same file extension", k, a, b))
+			continue
+		}
+
+		seen[suffix] = key
+	}
+
+	return
+}
+
+// directorySyncContentType returns the content type of the file name with content body.
+// The content type is that of the first of rules whose suffix the name ends with, ignoring case.
+// Otherwise it is looked up by file extension in directorySyncExtensionContentTypes, and is otherwise detected from the start of the content.
+func directorySyncContentType(name string, rules []directorySyncContentTypeRule, body io.ReadSeeker) (string, error) {
+	lowerName := strings.ToLower(name)
+
+	for _, rule := range rules {
+		if strings.HasSuffix(lowerName, rule.suffix) {
+			return rule.contentType, nil
+		}
+	}
+
+	if v, ok := directorySyncExtensionContentTypes[path.Ext(lowerName)]; ok {
+		return v, nil
+	}
+
+	buf := make([]byte, directorySyncSniffLen)
+	n, err := io.ReadFull(body, buf)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
@@ -0,0 +1,213 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBuildDirectorySyncFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"js/app.js":         "",
+		"js/app.js.map":     "{}",
+		"node_modules/x.js": "x",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := buildDirectorySyncFiles(dir, "site/", []string{"node_modules", "*.map"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"site/css/site.css": "/NzmttbiF19kBoaYgvbxzg==",
+		"site/index.html":   "yDMBQlsq0dSWRzpf89nsyg==",
+		"site/js/app.js":    "1B2M2Y8AsgTpgAmY7PhCfg==",
+	}
+
+	if got := directorySyncManifest(files); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	var names []string
+
+	for _, file := range files {
+		names = append(names, file.name)
+	}
+
+	if got, expected := strings.Join(names, ","), "css/site.css,index.html,js/app.js"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestDirectorySyncCacheControl(t *testing.T) {
+	t.Parallel()
+
+	rules := []directorySyncCacheControlRule{
+		{pattern: "*.html", value: "no-cache"},
+		{pattern: "assets/*", value: "public, max-age=31536000, immutable"},
+		{pattern: "*", value: "public, max-age=3600"},
+	}
+
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{"index.html", "no-cache"},
+		{"docs/index.html", "no-cache"},
+		{"assets/app.js", "public, max-age=31536000, immutable"},
+		{"assets/img/logo.png", "public, max-age=3600"},
+		{"robots.txt", "public, max-age=3600"},
+	}
+
+	for _, testCase := range testCases {
+		got, err := directorySyncCacheControl(testCase.name, rules)
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.name, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%s: got %q, expected %q", testCase.name, got, testCase.expected)
+		}
+	}
+
+	if got, err := directorySyncCacheControl("index.html", nil); err != nil || got != "" {
+		t.Errorf("no rules: got %q, %v, expected no value", got, err)
+	}
+}
+
+func TestDirectorySyncContentType(t *testing.T) {
+	t.Parallel()
+
+	rules := expandDirectorySyncContentTypeRules(map[string]string{
+		".md":     "text/markdown",
+		"WASM":    "application/wasm",
+		"js":      "application/javascript",
+		".min.js": "text/javascript",
+		"tar.gz":  "application/x-gtar",
+	})
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"README.md", "# Title", "text/markdown"},
+		{"app.wasm", "\x00asm", "application/wasm"},
+		{"site.css", "body {}", "text/css; charset=utf-8"},
+		{"INDEX.HTML", "<html></html>", "text/html; charset=utf-8"},
+		{"app.js", "console.log(1)", "application/javascript"},
+		{"app.min.js", "console.log(1)", "text/javascript"},
+		{"APP.MIN.JS", "console.log(1)", "text/javascript"},
+		{"site.tar.gz", "\x1f\x8b", "application/x-gtar"},
+		{"site.gz", "\x1f\x8b", "application/gzip"},
+		{"app.mjs", "console.log(1)", "text/javascript; charset=utf-8"},
+		{"font.woff2", "wOF2", "font/woff2"},
+		{"LICENSE", "Permission is hereby granted", "text/plain; charset=utf-8"},
+		{"data", "\x00\x01\x02", "application/octet-stream"},
+	}
+
+	for _, testCase := range testCases {
+		body := strings.NewReader(testCase.content)
+		got, err := directorySyncContentType(testCase.name, rules, body)
+
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", testCase.name, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%s: got %q, expected %q", testCase.name, got, testCase.expected)
+		}
+
+		if body.Len() != len(testCase.content) {
+			t.Errorf("%s: body not rewound", testCase.name)
+		}
+	}
+}
+
+func TestExpandDirectorySyncContentTypeRules(t *testing.T) {
+	t.Parallel()
+
+	rules := expandDirectorySyncContentTypeRules(map[string]string{
+		"JS":      "a",
+		".css":    "b",
+		"min.js":  "c",
+		".tar.gz": "d",
+	})
+
+	var suffixes []string
+
+	for _, rule := range rules {
+		suffixes = append(suffixes, rule.suffix)
+	}
+
+	if got, expected := strings.Join(suffixes, ","), ".min.js,.tar.gz,.css,.js"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestValidDirectorySyncContentTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		value       map[string]interface{}
+		expectedErr bool
+	}{
+		{"valid", map[string]interface{}{".md": "text/markdown", "min.js": "text/javascript", "js": "text/javascript"}, false},
+		{"same extension with and without dot", map[string]interface{}{"js": "a", ".js": "b"}, true},
+		{"same extension in different case", map[string]interface{}{".md": "a", ".MD": "b"}, true},
+		{"empty", map[string]interface{}{".": "a"}, true},
+	}
+
+	for _, testCase := range testCases {
+		_, errs := validDirectorySyncContentTypes(testCase.value, "content_types")
+
+		if got := len(errs) > 0; got != testCase.expectedErr {
+			t.Errorf("%s: got errors %v, expected error %t", testCase.name, errs, testCase.expectedErr)
+		}
+	}
+}
+
+func TestDirectorySyncObjectUnchanged(t *testing.T) {
+	t.Parallel()
+
+	// MD5 digest of "<html></html>".
+	checksum := "yDMBQlsq0dSWRzpf89nsyg=="
+	digest := "c83301425b2ad1d496473a5ff3d9ecca"
+
+	testCases := []struct {
+		name     string
+		etag     string
+		expected bool
+	}{
+		{"digest matches", digest, true},
+		{"digest differs", "d41d8cd98f00b204e9800998ecf8427e", false},
+		{"multipart ETag", digest + "-2", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := directorySyncObjectUnchanged(testCase.etag, checksum); got != testCase.expected {
+			t.Errorf("%s: got %t, expected %t", testCase.name, got, testCase.expected)
+		}
+	}
+}
@@ -0,0 +1,507 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+		"js/app.js":    "console.log(1)",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/css/site.css", "/NzmttbiF19kBoaYgvbxzg=="),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/index.html", "yDMBQlsq0dSWRzpf89nsyg=="),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/js/app.js"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "site/index.html", func(v *s3.HeadObjectOutput) error {
+						if got, expected := aws.StringValue(v.ContentType), "text/html; charset=utf-8"; got != expected {
+							return fmt.Errorf("Content-Type: got %q, expected %q", got, expected)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+		"old.html":     "<html>old</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFile(t, dir, "css/site.css", "body { margin: 0 }")
+					testAccDirectorySyncWriteFile(t, dir, "about.html", "<html>about</html>")
+
+					if err := os.Remove(filepath.Join(dir, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/index.html", "yDMBQlsq0dSWRzpf89nsyg=="),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/about.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "manifest.site/old.html"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/old.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_cacheControl(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"assets/app.js":  "console.log(1)",
+		"docs/README.md": "# Docs",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_cacheControl(rName, dir, "no-cache"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache_control.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "excludes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectorySyncObjectCacheControl(ctx, resourceName, "index.html", "no-cache"),
+					testAccCheckDirectorySyncObjectCacheControl(ctx, resourceName, "assets/app.js", "public, max-age=31536000, immutable"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_cacheControl(rName, dir, "max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncObjectCacheControl(ctx, resourceName, "index.html", "max-age=60"),
+					testAccCheckDirectorySyncObjectCacheControl(ctx, resourceName, "assets/app.js", "public, max-age=31536000, immutable"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_objectRemovedOutsideTerraform(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncDeleteObject(ctx, resourceName, "site/index.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_objectChangedOutsideTerraform(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					testAccCheckDirectorySyncPutObject(ctx, resourceName, "site/index.html", "<html>changed</html>"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.site/index.html", "yDMBQlsq0dSWRzpf89nsyg=="),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_kmsEncryptedBucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dir := testAccDirectorySyncCreateDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, s3.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_kmsEncryptedBucket(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "2"),
+				),
+			},
+			{
+				// The ETags of SSE-KMS encrypted objects aren't MD5 digests, which must not be taken for changes.
+				Config:   testAccDirectorySyncConfig_kmsEncryptedBucket(rName, dir),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncCreateDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		testAccDirectorySyncWriteFile(t, dir, name, content)
+	}
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFile(t *testing.T, dir, name, content string) {
+	p := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectorySyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Directory Sync ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		for _, key := range testAccDirectorySyncManifestKeys(rs) {
+			_, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+				Key:    aws.String(key),
+			})
+
+			if err != nil {
+				return fmt.Errorf("S3 Object (%s): %w", key, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			for _, key := range testAccDirectorySyncManifestKeys(rs) {
+				_, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+					Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+					Key:    aws.String(key),
+				})
+
+				if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, "NotFound") {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Directory Sync %s object (%s) still exists", rs.Primary.ID, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObject(ctx context.Context, n, key string, check func(*s3.HeadObjectOutput) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		output, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(rs.Primary.Attributes["key_prefix"] + key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("S3 Object (%s): %w", key, err)
+		}
+
+		return check(output)
+	}
+}
+
+func testAccCheckDirectorySyncObjectCacheControl(ctx context.Context, n, key, expected string) resource.TestCheckFunc {
+	return testAccCheckDirectorySyncObject(ctx, n, key, func(v *s3.HeadObjectOutput) error {
+		if got := aws.StringValue(v.CacheControl); got != expected {
+			return fmt.Errorf("S3 Object (%s) Cache-Control: got %q, expected %q", key, got, expected)
+		}
+
+		return nil
+	})
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err := conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if tfawserr.ErrCodeEquals(err, "NotFound") {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccCheckDirectorySyncDeleteObject(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err := conn.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckDirectorySyncPutObject(ctx context.Context, n, key, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
+
+		_, err := conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Body:   strings.NewReader(content),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccDirectorySyncManifestKeys(rs *terraform.ResourceState) []string {
+	var keys []string
+
+	for k := range rs.Primary.Attributes {
+		if key := strings.TrimPrefix(k, "manifest."); key != k && key != "%" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, dir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+}
+`, dir))
+}
+
+func testAccDirectorySyncConfig_kmsEncryptedBucket(rName, dir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
+  }
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket_server_side_encryption_configuration.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+}
+`, dir))
+}
+
+func testAccDirectorySyncConfig_deleteRemoved(rName, dir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source_dir     = %[1]q
+  delete_removed = true
+}
+`, dir))
+}
+
+func testAccDirectorySyncConfig_cacheControl(rName, dir, htmlCacheControl string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+  excludes   = ["docs"]
+
+  cache_control {
+    pattern = "*.html"
+    value   = %[2]q
+  }
+
+  cache_control {
+    pattern = "assets/*"
+    value   = "public, max-age=31536000, immutable"
+  }
+}
+`, dir, htmlCacheControl))
+}
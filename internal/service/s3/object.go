@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"net/http"
 	"os"
@@ -46,6 +48,7 @@ func ResourceObject() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			resourceObjectChecksumCustomizeDiff,
 			resourceObjectCustomizeDiff,
 			verify.SetTagsDiff,
 		),
@@ -72,6 +75,27 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"checksum_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ChecksumAlgorithm_Values(), false),
+			},
+			"checksum_crc32": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_crc32c": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha1": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -132,6 +156,16 @@ func ResourceObject() *schema.Resource {
 					return false
 				},
 			},
+			"multipart_upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+			"multipart_upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
 			"metadata": {
 				Type:         schema.TypeMap,
 				ValidateFunc: validateMetadataIsLowerCase,
@@ -207,6 +241,10 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 		Key:    aws.String(key),
 	}
 
+	if _, ok := d.GetOk("checksum_algorithm"); ok {
+		input.ChecksumMode = aws.String(s3.ChecksumModeEnabled)
+	}
+
 	var resp *s3.HeadObjectOutput
 
 	err := resource.RetryContext(ctx, objectCreationTimeout, func() *resource.RetryError {
@@ -241,8 +279,24 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	log.Printf("[DEBUG] Reading S3 Object meta: %s", resp)
 
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		attribute := objectChecksumAttribute(v.(string))
+		old := d.Get(attribute).(string)
+		got := flattenObjectChecksum(v.(string), resp.ChecksumCRC32, resp.ChecksumCRC32C, resp.ChecksumSHA1, resp.ChecksumSHA256)
+
+		// resourceObjectChecksumCustomizeDiff plans the checksum of the configured content,
+		// so a stored checksum that no longer matches it causes the next apply to upload the object again.
+		if old != "" && got != old {
+			log.Printf("[WARN] S3 Object (%s) %s checksum is %q, expected %q: the object was changed outside Terraform", d.Id(), v, got, old)
+		}
+	}
+
 	d.Set("bucket_key_enabled", resp.BucketKeyEnabled)
 	d.Set("cache_control", resp.CacheControl)
+	d.Set("checksum_crc32", resp.ChecksumCRC32)
+	d.Set("checksum_crc32c", resp.ChecksumCRC32C)
+	d.Set("checksum_sha1", resp.ChecksumSHA1)
+	d.Set("checksum_sha256", resp.ChecksumSHA256)
 	d.Set("content_disposition", resp.ContentDisposition)
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
@@ -428,11 +482,21 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Conn()
-	uploader := s3manager.NewUploaderWithClient(conn)
+	partSize := int64(d.Get("multipart_upload_part_size").(int))
+	concurrency := d.Get("multipart_upload_concurrency").(int)
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		if partSize > 0 {
+			u.PartSize = partSize
+		}
+
+		if concurrency > 0 {
+			u.Concurrency = concurrency
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	var body readSeekerAt
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -540,13 +604,34 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ObjectLockRetainUntilDate = expandObjectDate(v.(string))
 	}
 
-	if _, err := uploader.Upload(input); err != nil {
+	var checksum string
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		var err error
+		checksum, err = uploadObjectWithChecksum(ctx, conn, input, body, v.(string), partSize, concurrency)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
+		}
+	} else if _, err := uploader.UploadWithContext(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading object to S3 bucket (%s): %s", bucket, err)
 	}
 
 	d.SetId(key)
 
-	return append(diags, resourceObjectRead(ctx, d, meta)...)
+	diags = append(diags, resourceObjectRead(ctx, d, meta)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := d.GetOk("checksum_algorithm"); ok {
+		if got := d.Get(objectChecksumAttribute(v.(string))).(string); got != checksum {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Bucket (%s) Object (%s) %s checksum: expected %s, got %s", bucket, key, v, checksum, got)
+		}
+	}
+
+	return diags
 }
 
 func resourceObjectSetKMS(ctx context.Context, d *schema.ResourceData, meta interface{}, sseKMSKeyId *string) error {
@@ -580,6 +665,117 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 	return
 }
 
+// resourceObjectChecksumCustomizeDiff plans the checksum that S3 will report for the object's content
+// when checksum_algorithm is set, so that a difference from the checksum of the stored object,
+// either because the content changed locally or the object was changed outside Terraform, causes the object to be uploaded.
+func resourceObjectChecksumCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("checksum_algorithm") {
+		for _, attribute := range objectChecksumAttributes() {
+			if err := d.SetNewComputed(attribute); err != nil {
+				return err
+			}
+		}
+	}
+
+	v, ok := d.GetOk("checksum_algorithm")
+
+	if !ok {
+		return nil
+	}
+
+	algorithm := v.(string)
+	attribute := objectChecksumAttribute(algorithm)
+
+	// Don't read and hash a source file on every plan when etag or source_hash already tracks its content.
+	if _, ok := d.GetOk("source"); ok && d.Id() != "" && d.Get(attribute).(string) != "" {
+		rawConfig := d.GetRawConfig()
+		tracked := !rawConfig.GetAttr("etag").IsNull() || !rawConfig.GetAttr("source_hash").IsNull()
+
+		if tracked && !d.HasChanges("checksum_algorithm", "etag", "multipart_upload_part_size", "source", "source_hash") {
+			return nil
+		}
+	}
+
+	for _, key := range []string{"content", "content_base64", "multipart_upload_part_size", "source"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed(attribute)
+		}
+	}
+
+	body, err := objectBodyFromDiff(d)
+
+	if err != nil {
+		return err
+	}
+
+	if body == nil {
+		// The source file doesn't exist yet, e.g. it is created during apply.
+		return d.SetNewComputed(attribute)
+	}
+
+	if v, ok := body.(io.Closer); ok {
+		defer v.Close()
+	}
+
+	size, err := objectBodySize(body)
+
+	if err != nil {
+		return err
+	}
+
+	checksum, err := objectChecksum(body, size, int64(d.Get("multipart_upload_part_size").(int)), algorithm)
+
+	if err != nil {
+		return fmt.Errorf("computing %s checksum: %w", algorithm, err)
+	}
+
+	if old := d.Get(attribute).(string); old != checksum {
+		return d.SetNew(attribute, checksum)
+	}
+
+	return nil
+}
+
+// objectBodyFromDiff returns the object content configured by source, content or content_base64.
+// Returns nil if source is configured but the file doesn't exist.
+func objectBodyFromDiff(d *schema.ResourceDiff) (readSeekerAt, error) {
+	if v, ok := d.GetOk("source"); ok {
+		path, err := homedir.Expand(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("expanding homedir in source (%s): %w", v, err)
+		}
+
+		file, err := os.Open(path)
+
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, nil
+	}
+
+	if v, ok := d.GetOk("content"); ok {
+		return bytes.NewReader([]byte(v.(string))), nil
+	}
+
+	if v, ok := d.GetOk("content_base64"); ok {
+		content, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("decoding content_base64: %w", err)
+		}
+
+		return bytes.NewReader(content), nil
+	}
+
+	return bytes.NewReader([]byte{}), nil
+}
+
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
@@ -597,6 +793,11 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
 		"cache_control",
+		"checksum_algorithm",
+		"checksum_crc32",
+		"checksum_crc32c",
+		"checksum_sha1",
+		"checksum_sha256",
 		"content_base64",
 		"content_disposition",
 		"content_encoding",
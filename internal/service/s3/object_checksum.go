@@ -0,0 +1,372 @@
+package s3
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+const (
+	// S3 limits multipart uploads to 10,000 parts.
+	objectUploadMaxParts = 10000
+)
+
+// readSeekerAt is an object body that can be read concurrently, a part at a time.
+type readSeekerAt interface {
+	io.ReadSeeker
+	io.ReaderAt
+}
+
+func newObjectChecksumHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE(), nil
+	case s3.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case s3.ChecksumAlgorithmSha1:
+		return sha1.New(), nil
+	case s3.ChecksumAlgorithmSha256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+}
+
+// objectChecksumAttribute returns the name of the resource attribute that holds the checksum for algorithm.
+func objectChecksumAttribute(algorithm string) string {
+	return "checksum_" + strings.ToLower(algorithm)
+}
+
+// objectChecksumAttributes returns the names of all the resource attributes that hold checksums.
+func objectChecksumAttributes() []string {
+	var attributes []string
+
+	for _, algorithm := range s3.ChecksumAlgorithm_Values() {
+		attributes = append(attributes, objectChecksumAttribute(algorithm))
+	}
+
+	return attributes
+}
+
+// objectUploadPartSize returns the part size used to upload an object of the specified size.
+// The part size is increased if necessary to keep the number of parts within the S3 limit.
+func objectUploadPartSize(size, partSize int64) int64 {
+	if partSize <= 0 {
+		partSize = s3manager.DefaultUploadPartSize
+	}
+
+	if size/partSize >= objectUploadMaxParts {
+		partSize = size/objectUploadMaxParts + 1
+	}
+
+	return partSize
+}
+
+// objectPartChecksum returns the raw checksum of n bytes of body starting at offset off.
+func objectPartChecksum(body io.ReaderAt, off, n int64, algorithm string) ([]byte, error) {
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.Copy(h, io.NewSectionReader(body, off, n)); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// objectChecksum returns the checksum S3 reports for body when it is uploaded with the specified part size.
+// Objects no larger than the part size are uploaded in a single request and the checksum covers the whole object.
+// Larger objects are uploaded in parts and the checksum is the checksum of the concatenated part checksums,
+// suffixed with the number of parts.
+func objectChecksum(body io.ReaderAt, size, partSize int64, algorithm string) (string, error) {
+	partSize = objectUploadPartSize(size, partSize)
+
+	if size <= partSize {
+		sum, err := objectPartChecksum(body, 0, size, algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(sum), nil
+	}
+
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	var n int
+
+	for off := int64(0); off < size; off += partSize {
+		sum, err := objectPartChecksum(body, off, objectPartLength(partSize, size, off), algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		h.Write(sum)
+		n++
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), n), nil
+}
+
+// objectPartLength returns the length of the part starting at offset off.
+func objectPartLength(partSize, size, off int64) int64 {
+	if n := size - off; n < partSize {
+		return n
+	}
+
+	return partSize
+}
+
+func objectBodySize(body io.Seeker) (int64, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// expandObjectChecksum returns the checksum request fields with the field for algorithm set to checksum.
+func expandObjectChecksum(algorithm, checksum string) (crc32, crc32c, sha1, sha256 *string) {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		crc32 = aws.String(checksum)
+	case s3.ChecksumAlgorithmCrc32c:
+		crc32c = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha1:
+		sha1 = aws.String(checksum)
+	case s3.ChecksumAlgorithmSha256:
+		sha256 = aws.String(checksum)
+	}
+
+	return
+}
+
+// flattenObjectChecksum returns the checksum for algorithm from the checksum response fields.
+func flattenObjectChecksum(algorithm string, crc32, crc32c, sha1, sha256 *string) string {
+	switch algorithm {
+	case s3.ChecksumAlgorithmCrc32:
+		return aws.StringValue(crc32)
+	case s3.ChecksumAlgorithmCrc32c:
+		return aws.StringValue(crc32c)
+	case s3.ChecksumAlgorithmSha1:
+		return aws.StringValue(sha1)
+	case s3.ChecksumAlgorithmSha256:
+		return aws.StringValue(sha256)
+	default:
+		return ""
+	}
+}
+
+// uploadObjectWithChecksum uploads body, sending checksums computed with algorithm so that S3 verifies
+// the uploaded data and stores the object's checksum.
+// s3manager.Uploader isn't used because, as of aws-sdk-go v1.47, it copies ChecksumAlgorithm and precomputed
+// checksums only into single part PutObject requests: its UploadPart requests carry neither, and the v1 SDK
+// doesn't compute flexible checksums itself, so S3 rejects the parts of a multipart upload created with a
+// checksum algorithm. Objects larger than the part size are therefore uploaded here in parts, concurrency parts at a time.
+// The first part that fails cancels the parts in flight, no further parts are started and the upload is aborted.
+// Returns the checksum S3 is expected to report for the object.
+func uploadObjectWithChecksum(ctx context.Context, conn *s3.S3, input *s3manager.UploadInput, body readSeekerAt, algorithm string, partSize int64, concurrency int) (string, error) {
+	size, err := objectBodySize(body)
+
+	if err != nil {
+		return "", err
+	}
+
+	partSize = objectUploadPartSize(size, partSize)
+
+	if concurrency <= 0 {
+		concurrency = s3manager.DefaultUploadConcurrency
+	}
+
+	if size <= partSize {
+		sum, err := objectPartChecksum(body, 0, size, algorithm)
+
+		if err != nil {
+			return "", err
+		}
+
+		checksum := base64.StdEncoding.EncodeToString(sum)
+
+		putInput := &s3.PutObjectInput{}
+		awsutil.Copy(putInput, input)
+		putInput.Body = io.NewSectionReader(body, 0, size)
+		putInput.ChecksumAlgorithm = aws.String(algorithm)
+		putInput.ChecksumCRC32, putInput.ChecksumCRC32C, putInput.ChecksumSHA1, putInput.ChecksumSHA256 = expandObjectChecksum(algorithm, checksum)
+
+		if _, err := conn.PutObjectWithContext(ctx, putInput); err != nil {
+			return "", err
+		}
+
+		return checksum, nil
+	}
+
+	createInput := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(createInput, input)
+	createInput.ChecksumAlgorithm = aws.String(algorithm)
+
+	output, err := conn.CreateMultipartUploadWithContext(ctx, createInput)
+
+	if err != nil {
+		return "", fmt.Errorf("creating multipart upload: %w", err)
+	}
+
+	uploadID := aws.StringValue(output.UploadId)
+	partCount := int((size + partSize - 1) / partSize)
+	parts := make([]*s3.CompletedPart, partCount)
+	sums := make([][]byte, partCount)
+
+	// Stop scheduling parts once one fails and cancel the requests already in flight.
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for i := 0; i < partCount; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-uploadCtx.Done():
+		}
+
+		if uploadCtx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			partNumber := int64(i + 1)
+			off := int64(i) * partSize
+			n := objectPartLength(partSize, size, off)
+
+			sum, err := objectPartChecksum(body, off, n, algorithm)
+
+			if err != nil {
+				fail(err)
+				return
+			}
+
+			checksum := base64.StdEncoding.EncodeToString(sum)
+
+			partInput := &s3.UploadPartInput{
+				Body:              io.NewSectionReader(body, off, n),
+				Bucket:            input.Bucket,
+				ChecksumAlgorithm: aws.String(algorithm),
+				Key:               input.Key,
+				PartNumber:        aws.Int64(partNumber),
+				UploadId:          aws.String(uploadID),
+			}
+			partInput.ChecksumCRC32, partInput.ChecksumCRC32C, partInput.ChecksumSHA1, partInput.ChecksumSHA256 = expandObjectChecksum(algorithm, checksum)
+
+			partOutput, err := conn.UploadPartWithContext(uploadCtx, partInput)
+
+			if err != nil {
+				fail(fmt.Errorf("uploading part %d: %w", partNumber, err))
+				return
+			}
+
+			part := &s3.CompletedPart{
+				ETag:       partOutput.ETag,
+				PartNumber: aws.Int64(partNumber),
+			}
+			part.ChecksumCRC32, part.ChecksumCRC32C, part.ChecksumSHA1, part.ChecksumSHA256 = expandObjectChecksum(algorithm, checksum)
+
+			parts[i] = part
+			sums[i] = sum
+		}(i)
+	}
+
+	wg.Wait()
+
+	if firstErr == nil {
+		// The caller's context was cancelled.
+		firstErr = ctx.Err()
+	}
+
+	if firstErr != nil {
+		abortObjectMultipartUpload(ctx, conn, input, uploadID)
+
+		return "", firstErr
+	}
+
+	_, err = conn.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket: input.Bucket,
+		Key:    input.Key,
+		MultipartUpload: &s3.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: aws.String(uploadID),
+	})
+
+	if err != nil {
+		abortObjectMultipartUpload(ctx, conn, input, uploadID)
+
+		return "", fmt.Errorf("completing multipart upload: %w", err)
+	}
+
+	h, err := newObjectChecksumHash(algorithm)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, sum := range sums {
+		h.Write(sum)
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), partCount), nil
+}
+
+func abortObjectMultipartUpload(ctx context.Context, conn *s3.S3, input *s3manager.UploadInput, uploadID string) {
+	_, err := conn.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   input.Bucket,
+		Key:      input.Key,
+		UploadId: aws.String(uploadID),
+	})
+
+	if err != nil {
+		log.Printf("[WARN] Aborting S3 multipart upload (%s): %s", uploadID, err)
+	}
+}
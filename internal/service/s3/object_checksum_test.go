@@ -0,0 +1,191 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	partSum := func(s string) []byte {
+		sum := sha256.Sum256([]byte(s))
+		return sum[:]
+	}
+	compositeSum := sha256.Sum256(bytes.Join([][]byte{partSum("01234"), partSum("56789"), partSum("a")}, nil))
+
+	testCases := []struct {
+		name      string
+		body      string
+		partSize  int64
+		algorithm string
+		expected  string
+	}{
+		{
+			name:      "crc32",
+			body:      "hello",
+			algorithm: s3.ChecksumAlgorithmCrc32,
+			expected:  "NhCmhg==",
+		},
+		{
+			name:      "crc32c",
+			body:      "hello",
+			algorithm: s3.ChecksumAlgorithmCrc32c,
+			expected:  "mnG7TA==",
+		},
+		{
+			name:      "sha1",
+			body:      "hello",
+			algorithm: s3.ChecksumAlgorithmSha1,
+			expected:  "qvTGHdzF6KLavt4PO0gs2a6pQ00=",
+		},
+		{
+			name:      "sha256",
+			body:      "hello",
+			algorithm: s3.ChecksumAlgorithmSha256,
+			expected:  "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+		},
+		{
+			name:      "empty",
+			body:      "",
+			algorithm: s3.ChecksumAlgorithmCrc32,
+			expected:  "AAAAAA==",
+		},
+		{
+			name:      "single part",
+			body:      "0123456789",
+			partSize:  10,
+			algorithm: s3.ChecksumAlgorithmSha256,
+			expected:  base64.StdEncoding.EncodeToString(partSum("0123456789")),
+		},
+		{
+			name:      "multipart",
+			body:      "0123456789a",
+			partSize:  5,
+			algorithm: s3.ChecksumAlgorithmSha256,
+			expected:  base64.StdEncoding.EncodeToString(compositeSum[:]) + "-3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := strings.NewReader(testCase.body)
+			got, err := objectChecksum(body, body.Size(), testCase.partSize, testCase.algorithm)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestObjectUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		size     int64
+		partSize int64
+		expected int64
+	}{
+		{
+			name:     "default",
+			size:     1024,
+			expected: 5 * 1024 * 1024,
+		},
+		{
+			name:     "configured",
+			size:     1024,
+			partSize: 8 * 1024 * 1024,
+			expected: 8 * 1024 * 1024,
+		},
+		{
+			name:     "too many parts",
+			size:     100 * 1024 * 1024 * 1024,
+			partSize: 5 * 1024 * 1024,
+			expected: 100*1024*1024*1024/objectUploadMaxParts + 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := objectUploadPartSize(testCase.size, testCase.partSize); got != testCase.expected {
+			t.Errorf("%s: got %d, expected %d", testCase.name, got, testCase.expected)
+		}
+	}
+}
+
+func TestUploadObjectWithChecksumStopsOnError(t *testing.T) {
+	t.Parallel()
+
+	var uploadParts, aborts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		switch {
+		case r.Method == http.MethodPost && query.Has("uploads"):
+			fmt.Fprint(w, `<InitiateMultipartUploadResult><Bucket>bucket</Bucket><Key>key</Key><UploadId>upload</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPut && query.Has("partNumber"):
+			atomic.AddInt32(&uploadParts, 1)
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<Error><Code>InvalidRequest</Code><Message>failed</Message></Error>`)
+		case r.Method == http.MethodDelete && query.Has("uploadId"):
+			atomic.AddInt32(&aborts, 1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("id", "secret", ""),
+		Endpoint:         aws.String(server.URL),
+		MaxRetries:       aws.Int(0),
+		Region:           aws.String("us-west-2"), //lintignore:AWSAT003
+		S3ForcePathStyle: aws.Bool(true),
+	}))
+	conn := s3.New(sess)
+
+	input := &s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	}
+	body := strings.NewReader(strings.Repeat("a", 50))
+
+	_, err := uploadObjectWithChecksum(context.Background(), conn, input, body, s3.ChecksumAlgorithmSha256, 10, 1)
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	if got := atomic.LoadInt32(&uploadParts); got != 1 {
+		t.Errorf("got %d UploadPart requests, expected 1", got)
+	}
+
+	if got := atomic.LoadInt32(&aborts); got != 1 {
+		t.Errorf("got %d AbortMultipartUpload requests, expected 1", got)
+	}
+}
@@ -0,0 +1,471 @@
+package securityhub
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceConfigurationPolicy() *schema.Resource {
+	parameterValueSchema := func(elem *schema.Schema) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": elem,
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigurationPolicyCreate,
+		ReadWithoutTimeout:   resourceConfigurationPolicyRead,
+		UpdateWithoutTimeout: resourceConfigurationPolicyUpdate,
+		DeleteWithoutTimeout: resourceConfigurationPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled_standard_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_controls_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"disabled_control_identifiers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										ExactlyOneOf: []string{
+											"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers",
+											"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers",
+										},
+									},
+									"enabled_control_identifiers": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										ExactlyOneOf: []string{
+											"configuration_policy.0.security_controls_configuration.0.disabled_control_identifiers",
+											"configuration_policy.0.security_controls_configuration.0.enabled_control_identifiers",
+										},
+									},
+									"security_control_custom_parameter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"parameter": {
+													Type:     schema.TypeSet,
+													Required: true,
+													MinItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bool":        parameterValueSchema(&schema.Schema{Type: schema.TypeBool, Required: true}),
+															"double":      parameterValueSchema(&schema.Schema{Type: schema.TypeFloat, Required: true}),
+															"enum":        parameterValueSchema(&schema.Schema{Type: schema.TypeString, Required: true}),
+															"enum_list":   parameterValueSchema(&schema.Schema{Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}}),
+															"int":         parameterValueSchema(&schema.Schema{Type: schema.TypeInt, Required: true}),
+															"int_list":    parameterValueSchema(&schema.Schema{Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeInt}}),
+															"string":      parameterValueSchema(&schema.Schema{Type: schema.TypeString, Required: true}),
+															"string_list": parameterValueSchema(&schema.Schema{Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}}),
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"value_type": {
+																Type:             schema.TypeString,
+																Required:         true,
+																ValidateDiagFunc: enum.Validate[types.ParameterValueType](),
+															},
+														},
+													},
+												},
+												"security_control_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"service_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceConfigurationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient()
+
+	name := d.Get("name").(string)
+	input := &securityhub.CreateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d),
+		Name:                aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateConfigurationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Security Hub Configuration Policy (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient()
+
+	output, err := FindConfigurationPolicyByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Hub Configuration Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	if err := d.Set("configuration_policy", flattenPolicy(output.ConfigurationPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_policy: %s", err)
+	}
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	return diags
+}
+
+func resourceConfigurationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient()
+
+	input := &securityhub.UpdateConfigurationPolicyInput{
+		ConfigurationPolicy: expandPolicy(d),
+		Description:         aws.String(d.Get("description").(string)),
+		Identifier:          aws.String(d.Id()),
+		Name:                aws.String(d.Get("name").(string)),
+	}
+
+	_, err := conn.UpdateConfigurationPolicy(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceConfigurationPolicyRead(ctx, d, meta)...)
+}
+
+func resourceConfigurationPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecurityHubClient()
+
+	log.Printf("[DEBUG] Deleting Security Hub Configuration Policy: %s", d.Id())
+	_, err := conn.DeleteConfigurationPolicy(ctx, &securityhub.DeleteConfigurationPolicyInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Security Hub Configuration Policy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandPolicy(d *schema.ResourceData) types.Policy {
+	tfList := d.Get("configuration_policy").([]interface{})
+
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := types.SecurityHubPolicy{
+		ServiceEnabled: aws.Bool(tfMap["service_enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["enabled_standard_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.EnabledStandardIdentifiers = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["security_controls_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SecurityControlsConfiguration = expandSecurityControlsConfiguration(v[0].(map[string]interface{}), d.GetRawConfig())
+	}
+
+	return &types.PolicyMemberSecurityHub{
+		Value: apiObject,
+	}
+}
+
+func expandSecurityControlsConfiguration(tfMap map[string]interface{}, rawConfig cty.Value) *types.SecurityControlsConfiguration {
+	apiObject := &types.SecurityControlsConfiguration{}
+
+	// An empty set of disabled control identifiers enables every control, so
+	// use the configuration to tell it apart from an omitted argument.
+	if securityControlsConfigurationIsSet(rawConfig, "disabled_control_identifiers") {
+		apiObject.DisabledSecurityControlIdentifiers = flex.ExpandStringValueSet(tfMap["disabled_control_identifiers"].(*schema.Set))
+	}
+
+	if securityControlsConfigurationIsSet(rawConfig, "enabled_control_identifiers") {
+		apiObject.EnabledSecurityControlIdentifiers = flex.ExpandStringValueSet(tfMap["enabled_control_identifiers"].(*schema.Set))
+	}
+
+	if v, ok := tfMap["security_control_custom_parameter"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecurityControlCustomParameters = expandSecurityControlCustomParameters(v)
+	}
+
+	return apiObject
+}
+
+func securityControlsConfigurationIsSet(rawConfig cty.Value, name string) bool {
+	v := rawConfig
+	for _, step := range []string{"configuration_policy", "security_controls_configuration"} {
+		if !v.IsKnown() || v.IsNull() {
+			return false
+		}
+
+		v = v.GetAttr(step)
+
+		if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+			return false
+		}
+
+		v = v.Index(cty.NumberIntVal(0))
+	}
+
+	if !v.IsKnown() || v.IsNull() {
+		return false
+	}
+
+	return !v.GetAttr(name).IsNull()
+}
+
+func expandSecurityControlCustomParameters(tfList []interface{}) []types.SecurityControlCustomParameter {
+	var apiObjects []types.SecurityControlCustomParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.SecurityControlCustomParameter{
+			Parameters: map[string]types.ParameterConfiguration{},
+		}
+
+		if v, ok := tfMap["security_control_id"].(string); ok && v != "" {
+			apiObject.SecurityControlId = aws.String(v)
+		}
+
+		if v, ok := tfMap["parameter"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.Parameters[tfMap["name"].(string)] = expandParameterConfiguration(tfMap)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandParameterConfiguration(tfMap map[string]interface{}) types.ParameterConfiguration {
+	apiObject := types.ParameterConfiguration{
+		ValueType: types.ParameterValueType(tfMap["value_type"].(string)),
+	}
+
+	parameterValue := func(key string) (interface{}, bool) {
+		if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			return v[0].(map[string]interface{})["value"], true
+		}
+
+		return nil, false
+	}
+
+	if v, ok := parameterValue("bool"); ok {
+		apiObject.Value = &types.ParameterValueMemberBoolean{Value: v.(bool)}
+	} else if v, ok := parameterValue("double"); ok {
+		apiObject.Value = &types.ParameterValueMemberDouble{Value: v.(float64)}
+	} else if v, ok := parameterValue("enum"); ok {
+		apiObject.Value = &types.ParameterValueMemberEnum{Value: v.(string)}
+	} else if v, ok := parameterValue("enum_list"); ok {
+		apiObject.Value = &types.ParameterValueMemberEnumList{Value: flex.ExpandStringValueList(v.([]interface{}))}
+	} else if v, ok := parameterValue("int"); ok {
+		apiObject.Value = &types.ParameterValueMemberInteger{Value: int32(v.(int))}
+	} else if v, ok := parameterValue("int_list"); ok {
+		var values []int32
+		for _, v := range v.([]interface{}) {
+			values = append(values, int32(v.(int)))
+		}
+		apiObject.Value = &types.ParameterValueMemberIntegerList{Value: values}
+	} else if v, ok := parameterValue("string"); ok {
+		apiObject.Value = &types.ParameterValueMemberString{Value: v.(string)}
+	} else if v, ok := parameterValue("string_list"); ok {
+		apiObject.Value = &types.ParameterValueMemberStringList{Value: flex.ExpandStringValueList(v.([]interface{}))}
+	}
+
+	return apiObject
+}
+
+func flattenPolicy(apiObject types.Policy) []interface{} {
+	v, ok := apiObject.(*types.PolicyMemberSecurityHub)
+
+	if !ok {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled_standard_arns": v.Value.EnabledStandardIdentifiers,
+		"service_enabled":       aws.ToBool(v.Value.ServiceEnabled),
+	}
+
+	if v := v.Value.SecurityControlsConfiguration; v != nil {
+		tfMap["security_controls_configuration"] = []interface{}{flattenSecurityControlsConfiguration(v)}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSecurityControlsConfiguration(apiObject *types.SecurityControlsConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DisabledSecurityControlIdentifiers; v != nil {
+		tfMap["disabled_control_identifiers"] = v
+	}
+
+	if v := apiObject.EnabledSecurityControlIdentifiers; v != nil {
+		tfMap["enabled_control_identifiers"] = v
+	}
+
+	if v := apiObject.SecurityControlCustomParameters; v != nil {
+		tfMap["security_control_custom_parameter"] = flattenSecurityControlCustomParameters(v)
+	}
+
+	return tfMap
+}
+
+func flattenSecurityControlCustomParameters(apiObjects []types.SecurityControlCustomParameter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var parameters []interface{}
+
+		for name, parameter := range apiObject.Parameters {
+			parameters = append(parameters, flattenParameterConfiguration(name, parameter))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"parameter":           parameters,
+			"security_control_id": aws.ToString(apiObject.SecurityControlId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenParameterConfiguration(name string, apiObject types.ParameterConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":       name,
+		"value_type": string(apiObject.ValueType),
+	}
+
+	parameterValue := func(v interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{"value": v}}
+	}
+
+	switch v := apiObject.Value.(type) {
+	case *types.ParameterValueMemberBoolean:
+		tfMap["bool"] = parameterValue(v.Value)
+	case *types.ParameterValueMemberDouble:
+		tfMap["double"] = parameterValue(v.Value)
+	case *types.ParameterValueMemberEnum:
+		tfMap["enum"] = parameterValue(v.Value)
+	case *types.ParameterValueMemberEnumList:
+		tfMap["enum_list"] = parameterValue(v.Value)
+	case *types.ParameterValueMemberInteger:
+		tfMap["int"] = parameterValue(int(v.Value))
+	case *types.ParameterValueMemberIntegerList:
+		var values []interface{}
+		for _, v := range v.Value {
+			values = append(values, int(v))
+		}
+		tfMap["int_list"] = parameterValue(values)
+	case *types.ParameterValueMemberString:
+		tfMap["string"] = parameterValue(v.Value)
+	case *types.ParameterValueMemberStringList:
+		tfMap["string_list"] = parameterValue(v.Value)
+	}
+
+	return tfMap
+}
@@ -3,10 +3,15 @@ package securityhub
 import (
 	"context"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	securityhub_sdkv2 "github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindAdminAccount(ctx context.Context, conn *securityhub.SecurityHub, adminAccountID string) (*securityhub.AdminAccount, error) {
@@ -35,6 +40,38 @@ func FindAdminAccount(ctx context.Context, conn *securityhub.SecurityHub, adminA
 	return result, err
 }
 
+func FindAutomationRuleByARN(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.AutomationRulesConfig, error) {
+	input := &securityhub.BatchGetAutomationRulesInput{
+		AutomationRulesArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.BatchGetAutomationRulesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, securityhub.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if len(output.Rules) == 0 || output.Rules[0] == nil {
+		return nil, &resource.NotFoundError{
+			LastError:   unprocessedAutomationRulesError(output.UnprocessedAutomationRules),
+			LastRequest: input,
+		}
+	}
+
+	return output.Rules[0], nil
+}
+
 func FindInsight(ctx context.Context, conn *securityhub.SecurityHub, arn string) (*securityhub.Insight, error) {
 	input := &securityhub.GetInsightsInput{
 		InsightArns: aws.StringSlice([]string{arn}),
@@ -132,3 +169,28 @@ func FindStandardsSubscriptionByARN(ctx context.Context, conn *securityhub.Secur
 
 	return subscription, nil
 }
+
+func FindConfigurationPolicyByID(ctx context.Context, conn *securityhub_sdkv2.Client, id string) (*securityhub_sdkv2.GetConfigurationPolicyOutput, error) {
+	input := &securityhub_sdkv2.GetConfigurationPolicyInput{
+		Identifier: aws_sdkv2.String(id),
+	}
+
+	output, err := conn.GetConfigurationPolicy(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -23,6 +23,21 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"Description": testAccActionTarget_Description,
 			"Name":        testAccActionTarget_Name,
 		},
+		"AutomationRule": {
+			"basic":               testAccAutomationRule_basic,
+			"disappears":          testAccAutomationRule_disappears,
+			"update":              testAccAutomationRule_update,
+			"dateFilters":         testAccAutomationRule_dateFilters,
+			"mapFilters":          testAccAutomationRule_mapFilters,
+			"numberFilters":       testAccAutomationRule_numberFilters,
+			"findingFieldsUpdate": testAccAutomationRule_findingFieldsUpdate,
+			"tags":                testAccAutomationRule_tags,
+		},
+		"ConfigurationPolicy": {
+			"basic":      testAccConfigurationPolicy_basic,
+			"disappears": testAccConfigurationPolicy_disappears,
+			"controls":   testAccConfigurationPolicy_controls,
+		},
 		"Insight": {
 			"basic":            testAccInsight_basic,
 			"disappears":       testAccInsight_disappears,
@@ -0,0 +1,195 @@
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_securitylake_aws_log_source", resourceAWSLogSource)
+}
+
+func resourceAWSLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAWSLogSourceCreate,
+		ReadWithoutTimeout:   resourceAWSLogSourceRead,
+		DeleteWithoutTimeout: resourceAWSLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidAccountID,
+							},
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source_name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[types.AwsLogSourceName](),
+						},
+						"source_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringMatch(sourceVersionRegexp, "must be of the form 'x.y'"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameAWSLogSource = "AWS Log Source"
+)
+
+var sourceVersionRegexp = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+
+func resourceAWSLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	source := expandAWSLogSourceConfiguration(d.Get("source").([]interface{})[0].(map[string]interface{}))
+	sourceName := string(source.SourceName)
+	input := &securitylake.CreateAwsLogSourceInput{
+		Sources: []types.AwsLogSourceConfiguration{source},
+	}
+
+	output, err := conn.CreateAwsLogSource(ctx, input)
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed in accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameAWSLogSource, sourceName, err)
+	}
+
+	d.SetId(sourceName)
+
+	return resourceAWSLogSourceRead(ctx, d, meta)
+}
+
+func resourceAWSLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	source, err := FindAWSLogSourceBySourceName(ctx, conn, types.AwsLogSourceName(d.Id()))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake AWS Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionReading, ResNameAWSLogSource, d.Id(), err)
+	}
+
+	if err := d.Set("source", []interface{}{flattenAWSLogSourceConfiguration(source)}); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionSetting, ResNameAWSLogSource, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAWSLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	input := &securitylake.DeleteAwsLogSourceInput{
+		Sources: []types.AwsLogSourceConfiguration{
+			expandAWSLogSourceConfiguration(d.Get("source").([]interface{})[0].(map[string]interface{})),
+		},
+	}
+
+	log.Printf("[INFO] Deleting Security Lake AWS Log Source: %s", d.Id())
+	output, err := conn.DeleteAwsLogSource(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err == nil && len(output.Failed) > 0 {
+		err = fmt.Errorf("failed in accounts: %s", strings.Join(output.Failed, ", "))
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionDeleting, ResNameAWSLogSource, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAWSLogSourceConfiguration(tfMap map[string]interface{}) types.AwsLogSourceConfiguration {
+	apiObject := types.AwsLogSourceConfiguration{
+		SourceName: types.AwsLogSourceName(tfMap["source_name"].(string)),
+	}
+
+	if v, ok := tfMap["accounts"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Accounts = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["source_version"].(string); ok && v != "" {
+		apiObject.SourceVersion = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSLogSourceConfiguration(apiObject *types.AwsLogSourceConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"accounts":       flex.FlattenStringValueSet(apiObject.Accounts),
+		"regions":        flex.FlattenStringValueSet(apiObject.Regions),
+		"source_name":    string(apiObject.SourceName),
+		"source_version": aws.ToString(apiObject.SourceVersion),
+	}
+}
@@ -0,0 +1,298 @@
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_securitylake_custom_log_source", resourceCustomLogSource)
+}
+
+func resourceCustomLogSource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomLogSourceCreate,
+		ReadWithoutTimeout:   resourceCustomLogSourceRead,
+		DeleteWithoutTimeout: resourceCustomLogSourceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crawler_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"database_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"table_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crawler_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"provider_identity": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"external_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"principal": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"event_classes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"provider_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[\\\w\-_:/.]*$`), "must contain only alphanumeric characters, hyphens, underscores, colons, slashes and periods"),
+				),
+			},
+			"source_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(sourceVersionRegexp, "must be of the form 'x.y'"),
+			},
+		},
+	}
+}
+
+const (
+	ResNameCustomLogSource = "Custom Log Source"
+)
+
+func resourceCustomLogSourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	sourceName := d.Get("source_name").(string)
+	input := &securitylake.CreateCustomLogSourceInput{
+		SourceName: aws.String(sourceName),
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandCustomLogSourceConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("event_classes"); ok && v.(*schema.Set).Len() > 0 {
+		input.EventClasses = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("source_version"); ok {
+		input.SourceVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateCustomLogSource(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameCustomLogSource, sourceName, err)
+	}
+
+	if output == nil || output.Source == nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameCustomLogSource, sourceName, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(output.Source.SourceName))
+
+	return resourceCustomLogSourceRead(ctx, d, meta)
+}
+
+func resourceCustomLogSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	source, err := FindCustomLogSourceBySourceName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Custom Log Source (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionReading, ResNameCustomLogSource, d.Id(), err)
+	}
+
+	if err := d.Set("attributes", flattenCustomLogSourceAttributes(source.Attributes)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionSetting, ResNameCustomLogSource, d.Id(), err)
+	}
+	if err := d.Set("provider_details", flattenCustomLogSourceProvider(source.Provider)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionSetting, ResNameCustomLogSource, d.Id(), err)
+	}
+	d.Set("source_name", source.SourceName)
+	d.Set("source_version", source.SourceVersion)
+
+	return nil
+}
+
+func resourceCustomLogSourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	log.Printf("[INFO] Deleting Security Lake Custom Log Source: %s", d.Id())
+	_, err := conn.DeleteCustomLogSource(ctx, &securitylake.DeleteCustomLogSourceInput{
+		SourceName:    aws.String(d.Id()),
+		SourceVersion: aws.String(d.Get("source_version").(string)),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionDeleting, ResNameCustomLogSource, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCustomLogSourceConfiguration(tfMap map[string]interface{}) *types.CustomLogSourceConfiguration {
+	apiObject := &types.CustomLogSourceConfiguration{}
+
+	if v, ok := tfMap["crawler_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CrawlerConfiguration = &types.CustomLogSourceCrawlerConfiguration{}
+
+		if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+			apiObject.CrawlerConfiguration.RoleArn = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["provider_identity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProviderIdentity = expandAWSIdentity(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAWSIdentity(tfMap map[string]interface{}) *types.AwsIdentity {
+	apiObject := &types.AwsIdentity{}
+
+	if v, ok := tfMap["external_id"].(string); ok && v != "" {
+		apiObject.ExternalId = aws.String(v)
+	}
+
+	if v, ok := tfMap["principal"].(string); ok && v != "" {
+		apiObject.Principal = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAWSIdentity(apiObject *types.AwsIdentity) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"external_id": aws.ToString(apiObject.ExternalId),
+		"principal":   aws.ToString(apiObject.Principal),
+	}}
+}
+
+func flattenCustomLogSourceAttributes(apiObject *types.CustomLogSourceAttributes) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"crawler_arn":  aws.ToString(apiObject.CrawlerArn),
+		"database_arn": aws.ToString(apiObject.DatabaseArn),
+		"table_arn":    aws.ToString(apiObject.TableArn),
+	}}
+}
+
+func flattenCustomLogSourceProvider(apiObject *types.CustomLogSourceProvider) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"location": aws.ToString(apiObject.Location),
+		"role_arn": aws.ToString(apiObject.RoleArn),
+	}}
+}
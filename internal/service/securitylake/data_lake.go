@@ -0,0 +1,411 @@
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_securitylake_data_lake", resourceDataLake)
+}
+
+func resourceDataLake() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataLakeCreate,
+		ReadWithoutTimeout:   resourceDataLakeRead,
+		UpdateWithoutTimeout: resourceDataLakeUpdate,
+		DeleteWithoutTimeout: resourceDataLakeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "S3_MANAGED_KEY",
+									},
+								},
+							},
+						},
+						"lifecycle_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+									"transition": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"storage_class": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"replication_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"role_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"meta_store_manager_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+const (
+	ResNameDataLake = "Data Lake"
+)
+
+func resourceDataLakeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	input := &securitylake.CreateDataLakeInput{
+		Configurations:          expandDataLakeConfigurations(d.Get("configuration").([]interface{})),
+		MetaStoreManagerRoleArn: aws.String(d.Get("meta_store_manager_role_arn").(string)),
+	}
+
+	output, err := conn.CreateDataLake(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameDataLake, "", err)
+	}
+
+	if output == nil || len(output.DataLakes) == 0 || output.DataLakes[0].DataLakeArn == nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameDataLake, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(output.DataLakes[0].DataLakeArn))
+
+	if _, err := waitDataLakeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionWaitingForCreation, ResNameDataLake, d.Id(), err)
+	}
+
+	return resourceDataLakeRead(ctx, d, meta)
+}
+
+func resourceDataLakeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	dataLake, err := FindDataLakeByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Data Lake (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionReading, ResNameDataLake, d.Id(), err)
+	}
+
+	d.Set("arn", dataLake.DataLakeArn)
+	if err := d.Set("configuration", []interface{}{flattenDataLakeResource(dataLake)}); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionSetting, ResNameDataLake, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDataLakeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	if d.HasChange("configuration") {
+		input := &securitylake.UpdateDataLakeInput{
+			Configurations: expandDataLakeConfigurations(d.Get("configuration").([]interface{})),
+		}
+
+		_, err := conn.UpdateDataLake(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.SecurityLake, create.ErrActionUpdating, ResNameDataLake, d.Id(), err)
+		}
+
+		if _, err := waitDataLakeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.DiagError(names.SecurityLake, create.ErrActionWaitingForUpdate, ResNameDataLake, d.Id(), err)
+		}
+	}
+
+	return resourceDataLakeRead(ctx, d, meta)
+}
+
+func resourceDataLakeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	region, err := dataLakeRegion(d.Id())
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionDeleting, ResNameDataLake, d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting Security Lake Data Lake: %s", d.Id())
+	_, err = conn.DeleteDataLake(ctx, &securitylake.DeleteDataLakeInput{
+		Regions: []string{region},
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionDeleting, ResNameDataLake, d.Id(), err)
+	}
+
+	if _, err := waitDataLakeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionWaitingForDeletion, ResNameDataLake, d.Id(), err)
+	}
+
+	return nil
+}
+
+// dataLakeRegion returns the Region of the data lake with the specified ARN.
+// Security Lake data lakes are addressed by Region rather than by ARN.
+func dataLakeRegion(dataLakeARN string) (string, error) {
+	v, err := arn.Parse(dataLakeARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	return v.Region, nil
+}
+
+func expandDataLakeConfigurations(tfList []interface{}) []types.DataLakeConfiguration {
+	var apiObjects []types.DataLakeConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.DataLakeConfiguration{}
+
+		if v, ok := tfMap["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.EncryptionConfiguration = expandDataLakeEncryptionConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["lifecycle_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LifecycleConfiguration = expandDataLakeLifecycleConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["region"].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap["replication_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ReplicationConfiguration = expandDataLakeReplicationConfiguration(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDataLakeEncryptionConfiguration(tfMap map[string]interface{}) *types.DataLakeEncryptionConfiguration {
+	apiObject := &types.DataLakeEncryptionConfiguration{}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDataLakeLifecycleConfiguration(tfMap map[string]interface{}) *types.DataLakeLifecycleConfiguration {
+	apiObject := &types.DataLakeLifecycleConfiguration{}
+
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Expiration = &types.DataLakeLifecycleExpiration{}
+
+		if v, ok := tfMap["days"].(int); ok && v > 0 {
+			apiObject.Expiration.Days = aws.Int32(int32(v))
+		}
+	}
+
+	if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			transition := types.DataLakeLifecycleTransition{}
+
+			if v, ok := tfMap["days"].(int); ok {
+				transition.Days = aws.Int32(int32(v))
+			}
+
+			if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+				transition.StorageClass = aws.String(v)
+			}
+
+			apiObject.Transitions = append(apiObject.Transitions, transition)
+		}
+	}
+
+	return apiObject
+}
+
+func expandDataLakeReplicationConfiguration(tfMap map[string]interface{}) *types.DataLakeReplicationConfiguration {
+	apiObject := &types.DataLakeReplicationConfiguration{}
+
+	if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Regions = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		apiObject.RoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDataLakeResource(apiObject *types.DataLakeResource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"region": aws.ToString(apiObject.Region),
+	}
+
+	if v := apiObject.EncryptionConfiguration; v != nil {
+		tfMap["encryption_configuration"] = []interface{}{map[string]interface{}{
+			"kms_key_id": aws.ToString(v.KmsKeyId),
+		}}
+	}
+
+	if v := apiObject.LifecycleConfiguration; v != nil && (v.Expiration != nil || len(v.Transitions) > 0) {
+		tfMap["lifecycle_configuration"] = []interface{}{flattenDataLakeLifecycleConfiguration(v)}
+	}
+
+	if v := apiObject.ReplicationConfiguration; v != nil && (len(v.Regions) > 0 || v.RoleArn != nil) {
+		tfMap["replication_configuration"] = []interface{}{map[string]interface{}{
+			"regions":  flex.FlattenStringValueSet(v.Regions),
+			"role_arn": aws.ToString(v.RoleArn),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenDataLakeLifecycleConfiguration(apiObject *types.DataLakeLifecycleConfiguration) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Expiration; v != nil {
+		tfMap["expiration"] = []interface{}{map[string]interface{}{
+			"days": aws.ToInt32(v.Days),
+		}}
+	}
+
+	var transitions []interface{}
+
+	for _, v := range apiObject.Transitions {
+		transitions = append(transitions, map[string]interface{}{
+			"days":          aws.ToInt32(v.Days),
+			"storage_class": aws.ToString(v.StorageClass),
+		})
+	}
+
+	tfMap["transition"] = transitions
+
+	return tfMap
+}
@@ -0,0 +1,10 @@
+package securitylake
+
+// Exports for use in tests only.
+var (
+	ResourceAWSLogSource           = resourceAWSLogSource
+	ResourceCustomLogSource        = resourceCustomLogSource
+	ResourceDataLake               = resourceDataLake
+	ResourceSubscriber             = resourceSubscriber
+	ResourceSubscriberNotification = resourceSubscriberNotification
+)
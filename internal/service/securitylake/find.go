@@ -0,0 +1,168 @@
+package securitylake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"golang.org/x/exp/slices"
+)
+
+func FindDataLakeByARN(ctx context.Context, conn *securitylake.Client, arn string) (*types.DataLakeResource, error) {
+	region, err := dataLakeRegion(arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	}
+
+	output, err := conn.ListDataLakes(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.DataLakes {
+		if aws.ToString(v.DataLakeArn) == arn {
+			v := v
+
+			return &v, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// FindAWSLogSourceBySourceName returns the configuration of the natively supported AWS log source
+// with the specified name, aggregated over all the accounts and Regions it is enabled in.
+func FindAWSLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName types.AwsLogSourceName) (*types.AwsLogSourceConfiguration, error) {
+	input := &securitylake.ListLogSourcesInput{}
+	output := &types.AwsLogSourceConfiguration{
+		SourceName: sourceName,
+	}
+
+	err := listLogSourcesPages(ctx, conn, input, func(source types.LogSource, resource types.LogSourceResource) {
+		v, ok := resource.(*types.LogSourceResourceMemberAwsLogSource)
+
+		if !ok || v.Value.SourceName != sourceName {
+			return
+		}
+
+		if v := aws.ToString(source.Account); v != "" && !slices.Contains(output.Accounts, v) {
+			output.Accounts = append(output.Accounts, v)
+		}
+
+		if v := aws.ToString(source.Region); v != "" && !slices.Contains(output.Regions, v) {
+			output.Regions = append(output.Regions, v)
+		}
+
+		output.SourceVersion = v.Value.SourceVersion
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Regions) == 0 {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindCustomLogSourceBySourceName(ctx context.Context, conn *securitylake.Client, sourceName string) (*types.CustomLogSourceResource, error) {
+	input := &securitylake.ListLogSourcesInput{}
+	var output *types.CustomLogSourceResource
+
+	err := listLogSourcesPages(ctx, conn, input, func(_ types.LogSource, resource types.LogSourceResource) {
+		if v, ok := resource.(*types.LogSourceResourceMemberCustomLogSource); ok && aws.ToString(v.Value.SourceName) == sourceName {
+			output = &v.Value
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func listLogSourcesPages(ctx context.Context, conn *securitylake.Client, input *securitylake.ListLogSourcesInput, fn func(types.LogSource, types.LogSourceResource)) error {
+	paginator := securitylake.NewListLogSourcesPaginator(conn, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, source := range page.Sources {
+			for _, resource := range source.Sources {
+				fn(source, resource)
+			}
+		}
+	}
+
+	return nil
+}
+
+func FindSubscriberByID(ctx context.Context, conn *securitylake.Client, id string) (*types.SubscriberResource, error) {
+	input := &securitylake.GetSubscriberInput{
+		SubscriberId: aws.String(id),
+	}
+
+	output, err := conn.GetSubscriber(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Subscriber == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Subscriber, nil
+}
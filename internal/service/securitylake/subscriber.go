@@ -0,0 +1,339 @@
+package securitylake
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerSDKResourceFactory("aws_securitylake_subscriber", resourceSubscriber)
+}
+
+func resourceSubscriber() *schema.Resource {
+	logSourceResourceSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"source_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"source_version": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringMatch(sourceVersionRegexp, "must be of the form 'x.y'"),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSubscriberCreate,
+		ReadWithoutTimeout:   resourceSubscriberRead,
+		UpdateWithoutTimeout: resourceSubscriberUpdate,
+		DeleteWithoutTimeout: resourceSubscriberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessType](),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_share_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_log_source_resource":    logSourceResourceSchema(),
+						"custom_log_source_resource": logSourceResourceSchema(),
+					},
+				},
+			},
+			"subscriber_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subscriber_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscriber_identity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"subscriber_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"subscriber_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	ResNameSubscriber = "Subscriber"
+)
+
+func resourceSubscriberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	name := d.Get("subscriber_name").(string)
+	input := &securitylake.CreateSubscriberInput{
+		Sources:            expandLogSourceResources(d.Get("source").(*schema.Set).List()),
+		SubscriberIdentity: expandAWSIdentity(d.Get("subscriber_identity").([]interface{})[0].(map[string]interface{})),
+		SubscriberName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("access_type"); ok {
+		input.AccessTypes = []types.AccessType{types.AccessType(v.(string))}
+	}
+
+	if v, ok := d.GetOk("subscriber_description"); ok {
+		input.SubscriberDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateSubscriber(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameSubscriber, name, err)
+	}
+
+	if output == nil || output.Subscriber == nil || output.Subscriber.SubscriberId == nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionCreating, ResNameSubscriber, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(output.Subscriber.SubscriberId))
+
+	if _, err := waitSubscriberCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionWaitingForCreation, ResNameSubscriber, d.Id(), err)
+	}
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	subscriber, err := FindSubscriberByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Security Lake Subscriber (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionReading, ResNameSubscriber, d.Id(), err)
+	}
+
+	if len(subscriber.AccessTypes) > 0 {
+		d.Set("access_type", subscriber.AccessTypes[0])
+	} else {
+		d.Set("access_type", nil)
+	}
+	d.Set("arn", subscriber.SubscriberArn)
+	d.Set("resource_share_arn", subscriber.ResourceShareArn)
+	d.Set("resource_share_name", subscriber.ResourceShareName)
+	d.Set("role_arn", subscriber.RoleArn)
+	if err := d.Set("source", flattenLogSourceResources(subscriber.Sources)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionSetting, ResNameSubscriber, d.Id(), err)
+	}
+	d.Set("subscriber_description", subscriber.SubscriberDescription)
+	d.Set("subscriber_endpoint", subscriber.SubscriberEndpoint)
+	if err := d.Set("subscriber_identity", flattenAWSIdentity(subscriber.SubscriberIdentity)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionSetting, ResNameSubscriber, d.Id(), err)
+	}
+	d.Set("subscriber_name", subscriber.SubscriberName)
+	d.Set("subscriber_status", subscriber.SubscriberStatus)
+
+	return nil
+}
+
+func resourceSubscriberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	input := &securitylake.UpdateSubscriberInput{
+		SubscriberId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("source") {
+		input.Sources = expandLogSourceResources(d.Get("source").(*schema.Set).List())
+	}
+
+	if d.HasChange("subscriber_description") {
+		input.SubscriberDescription = aws.String(d.Get("subscriber_description").(string))
+	}
+
+	if d.HasChange("subscriber_identity") {
+		input.SubscriberIdentity = expandAWSIdentity(d.Get("subscriber_identity").([]interface{})[0].(map[string]interface{}))
+	}
+
+	if d.HasChange("subscriber_name") {
+		input.SubscriberName = aws.String(d.Get("subscriber_name").(string))
+	}
+
+	_, err := conn.UpdateSubscriber(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionUpdating, ResNameSubscriber, d.Id(), err)
+	}
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SecurityLakeClient()
+
+	log.Printf("[INFO] Deleting Security Lake Subscriber: %s", d.Id())
+	_, err := conn.DeleteSubscriber(ctx, &securitylake.DeleteSubscriberInput{
+		SubscriberId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionDeleting, ResNameSubscriber, d.Id(), err)
+	}
+
+	if _, err := waitSubscriberDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.SecurityLake, create.ErrActionWaitingForDeletion, ResNameSubscriber, d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLogSourceResources(tfList []interface{}) []types.LogSourceResource {
+	var apiObjects []types.LogSourceResource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["aws_log_source_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := &types.LogSourceResourceMemberAwsLogSource{
+				Value: types.AwsLogSourceResource{
+					SourceName: types.AwsLogSourceName(tfMap["source_name"].(string)),
+				},
+			}
+
+			if v, ok := tfMap["source_version"].(string); ok && v != "" {
+				apiObject.Value.SourceVersion = aws.String(v)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+
+		if v, ok := tfMap["custom_log_source_resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject := &types.LogSourceResourceMemberCustomLogSource{
+				Value: types.CustomLogSourceResource{
+					SourceName: aws.String(tfMap["source_name"].(string)),
+				},
+			}
+
+			if v, ok := tfMap["source_version"].(string); ok && v != "" {
+				apiObject.Value.SourceVersion = aws.String(v)
+			}
+
+			apiObjects = append(apiObjects, apiObject)
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenLogSourceResources(apiObjects []types.LogSourceResource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *types.LogSourceResourceMemberAwsLogSource:
+			tfList = append(tfList, map[string]interface{}{
+				"aws_log_source_resource": []interface{}{map[string]interface{}{
+					"source_name":    string(v.Value.SourceName),
+					"source_version": aws.ToString(v.Value.SourceVersion),
+				}},
+			})
+		case *types.LogSourceResourceMemberCustomLogSource:
+			tfList = append(tfList, map[string]interface{}{
+				"custom_log_source_resource": []interface{}{map[string]interface{}{
+					"source_name":    aws.ToString(v.Value.SourceName),
+					"source_version": aws.ToString(v.Value.SourceVersion),
+				}},
+			})
+		}
+	}
+
+	return tfList
+}
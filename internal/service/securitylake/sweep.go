@@ -0,0 +1,114 @@
+//go:build sweep
+// +build sweep
+
+package securitylake
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_securitylake_data_lake", &resource.Sweeper{
+		Name: "aws_securitylake_data_lake",
+		F:    sweepDataLakes,
+		Dependencies: []string{
+			"aws_securitylake_subscriber",
+		},
+	})
+
+	resource.AddTestSweepers("aws_securitylake_subscriber", &resource.Sweeper{
+		Name: "aws_securitylake_subscriber",
+		F:    sweepSubscribers,
+	})
+}
+
+func sweepDataLakes(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SecurityLakeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	output, err := conn.ListDataLakes(ctx, &securitylake.ListDataLakesInput{
+		Regions: []string{region},
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Security Lake Data Lake sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Security Lake Data Lakes (%s): %w", region, err)
+	}
+
+	for _, v := range output.DataLakes {
+		r := resourceDataLake()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.DataLakeArn))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Security Lake Data Lakes (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepSubscribers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SecurityLakeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := securitylake.NewListSubscribersPaginator(conn, &securitylake.ListSubscribersInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Security Lake Subscriber sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing Security Lake Subscribers (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Subscribers {
+			r := resourceSubscriber()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.SubscriberId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Security Lake Subscribers (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,119 @@
+package securitylake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake"
+	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitDataLakeCreated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending),
+		Target:                    enum.Slice(types.DataLakeStatusCompleted),
+		Refresh:                   statusDataLakeCreate(ctx, conn, arn),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		tfresource.SetLastError(err, dataLakeUpdateError(output.UpdateStatus))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeUpdated(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending),
+		Target:                    enum.Slice(types.DataLakeStatusCompleted),
+		Refresh:                   statusDataLakeUpdate(ctx, conn, arn),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		tfresource.SetLastError(err, dataLakeUpdateError(output.UpdateStatus))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataLakeDeleted(ctx context.Context, conn *securitylake.Client, arn string, timeout time.Duration) (*types.DataLakeResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.DataLakeStatusInitialized, types.DataLakeStatusPending, types.DataLakeStatusCompleted),
+		Target:  []string{},
+		Refresh: statusDataLakeCreate(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DataLakeResource); ok {
+		tfresource.SetLastError(err, dataLakeUpdateError(output.UpdateStatus))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberCreated(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*types.SubscriberResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.SubscriberStatusPending),
+		Target:                    enum.Slice(types.SubscriberStatusActive, types.SubscriberStatusReady),
+		Refresh:                   statusSubscriber(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSubscriberDeleted(ctx context.Context, conn *securitylake.Client, id string, timeout time.Duration) (*types.SubscriberResource, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.SubscriberStatusActive, types.SubscriberStatusDeactivated, types.SubscriberStatusPending, types.SubscriberStatusReady),
+		Target:  []string{},
+		Refresh: statusSubscriber(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.SubscriberResource); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func dataLakeUpdateError(apiObject *types.DataLakeUpdateStatus) error {
+	if apiObject == nil || apiObject.Exception == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.ToString(apiObject.Exception.Code), aws.ToString(apiObject.Exception.Reason))
+}
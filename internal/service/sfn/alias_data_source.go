@@ -0,0 +1,83 @@
+package sfn
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAlias() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAliasRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"routing_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state_machine_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"statemachine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	name := d.Get("name").(string)
+	stateMachineARN := d.Get("statemachine_arn").(string)
+	// Alias ARNs are the state machine ARN suffixed with ":<alias name>".
+	arn := stateMachineARN + ":" + name
+	output, err := FindAliasByARN(ctx, conn, arn)
+
+	if err != nil {
+		return diag.Errorf("reading Step Functions State Machine Alias (%s): %s", arn, err)
+	}
+
+	d.SetId(arn)
+	d.Set("arn", output.StateMachineAliasArn)
+	d.Set("creation_date", aws.TimeValue(output.CreationDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+	if err := d.Set("routing_configuration", flattenRoutingConfigurationListItems(output.RoutingConfiguration)); err != nil {
+		return diag.Errorf("setting routing_configuration: %s", err)
+	}
+
+	return nil
+}
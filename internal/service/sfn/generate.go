@@ -1,3 +1,4 @@
+//go:generate go run ../../generate/listpages/main.go -ListOps=ListStateMachineVersions -ContextOnly
 //go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags -ContextOnly
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,9 +43,12 @@ func ResourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: verify.ValidAllDiag(
+					validation.ToDiagFunc(validation.StringLenBetween(0, 1024*1024)), // 1048576
+					validStateMachineDefinition,
+				),
 			},
 			"logging_configuration": {
 				Type:     schema.TypeList,
@@ -92,11 +96,24 @@ func ResourceStateMachine() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]+$`), "the name should only contain 0-9, A-Z, a-z, - and _"),
 				),
 			},
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"state_machine_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -125,9 +142,17 @@ func ResourceStateMachine() *schema.Resource {
 				Default:      sfn.StateMachineTypeStandard,
 				ValidateFunc: validation.StringInSlice(sfn.StateMachineType_Values(), false),
 			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			stateMachineUpdateComputedAttributesOnPublish,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -140,6 +165,7 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 	input := &sfn.CreateStateMachineInput{
 		Definition: aws.String(d.Get("definition").(string)),
 		Name:       aws.String(name),
+		Publish:    aws.Bool(d.Get("publish").(bool)),
 		RoleArn:    aws.String(d.Get("role_arn").(string)),
 		Tags:       Tags(tags.IgnoreAWS()),
 		Type:       aws.String(d.Get("type").(string)),
@@ -153,6 +179,10 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 		input.TracingConfiguration = expandTracingConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("version_description"); ok {
+		input.VersionDescription = aws.String(v.(string))
+	}
+
 	// This is done to deal with IAM eventual consistency.
 	// Note: the instance may be in a deleting mode, hence the retry
 	// when creating the step function. This can happen when we are
@@ -165,7 +195,10 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("creating Step Functions State Machine (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(outputRaw.(*sfn.CreateStateMachineOutput).StateMachineArn))
+	output := outputRaw.(*sfn.CreateStateMachineOutput)
+	d.SetId(aws.StringValue(output.StateMachineArn))
+	// The version ARN is only returned when a version is published.
+	d.Set("state_machine_version_arn", output.StateMachineVersionArn)
 
 	return resourceStateMachineRead(ctx, d, meta)
 }
@@ -203,8 +236,25 @@ func resourceStateMachineRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	d.Set("name", output.Name)
 	d.Set("name_prefix", create.NamePrefixFromName(aws.StringValue(output.Name)))
+	d.Set("revision_id", output.RevisionId)
 	d.Set("role_arn", output.RoleArn)
 	d.Set("status", output.Status)
+
+	// Versions are only looked up for state machines that publish them, so callers without
+	// states:ListStateMachineVersions permission can still manage unversioned state machines.
+	if d.Get("publish").(bool) || d.Get("state_machine_version_arn").(string) != "" {
+		version, err := findLatestStateMachineVersion(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err), tfawserr.ErrCodeEquals(err, "AccessDeniedException"):
+			// Keep any version ARN returned by a publish that isn't listed, or can't be listed.
+		case err != nil:
+			return diag.Errorf("reading Step Functions State Machine (%s) versions: %s", d.Id(), err)
+		default:
+			d.Set("state_machine_version_arn", version.StateMachineVersionArn)
+		}
+	}
+
 	if output.TracingConfiguration != nil {
 		if err := d.Set("tracing_configuration", []interface{}{flattenTracingConfiguration(output.TracingConfiguration)}); err != nil {
 			return diag.Errorf("setting tracing_configuration: %s", err)
@@ -249,6 +299,14 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 			StateMachineArn: aws.String(d.Id()),
 		}
 
+		if d.Get("publish").(bool) {
+			input.Publish = aws.Bool(true)
+
+			if v, ok := d.GetOk("version_description"); ok {
+				input.VersionDescription = aws.String(v.(string))
+			}
+		}
+
 		if d.HasChange("logging_configuration") {
 			if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.LoggingConfiguration = expandLoggingConfiguration(v.([]interface{})[0].(map[string]interface{}))
@@ -261,12 +319,16 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 		}
 
-		_, err := conn.UpdateStateMachineWithContext(ctx, input)
+		output, err := conn.UpdateStateMachineWithContext(ctx, input)
 
 		if err != nil {
 			return diag.Errorf("updating Step Functions State Machine (%s): %s", d.Id(), err)
 		}
 
+		if input.Publish != nil {
+			d.Set("state_machine_version_arn", output.StateMachineVersionArn)
+		}
+
 		// Handle eventual consistency after update.
 		err = resource.RetryContext(ctx, stateMachineUpdatedTimeout, func() *resource.RetryError { // nosemgrep:ci.helper-schema-resource-Retry-without-TimeoutError-check
 			output, err := FindStateMachineByARN(ctx, conn, d.Id())
@@ -321,6 +383,28 @@ func resourceStateMachineDelete(ctx context.Context, d *schema.ResourceData, met
 	return nil
 }
 
+// stateMachineUpdateComputedAttributesOnPublish marks the revision and version
+// ARN as unknown when an update will create a new revision or publish a version.
+func stateMachineUpdateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChanges("definition", "logging_configuration", "role_arn", "tracing_configuration") {
+		if err := d.SetNewComputed("revision_id"); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("publish").(bool) && d.HasChanges("definition", "logging_configuration", "publish", "role_arn", "tracing_configuration") {
+		if err := d.SetNewComputed("state_machine_version_arn"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineOutput, error) {
 	input := &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(arn),
@@ -346,6 +430,34 @@ func FindStateMachineByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn
 	return output, nil
 }
 
+// findLatestStateMachineVersion returns the most recently published version of the state machine.
+func findLatestStateMachineVersion(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.StateMachineVersionListItem, error) {
+	// Versions are listed in descending order of creation.
+	input := &sfn.ListStateMachineVersionsInput{
+		MaxResults:      aws.Int64(1),
+		StateMachineArn: aws.String(arn),
+	}
+
+	output, err := conn.ListStateMachineVersionsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeStateMachineDoesNotExist) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.StateMachineVersions) == 0 || output.StateMachineVersions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StateMachineVersions[0], nil
+}
+
 func statusStateMachine(ctx context.Context, conn *sfn.SFN, stateMachineArn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindStateMachineByARN(ctx, conn, stateMachineArn)
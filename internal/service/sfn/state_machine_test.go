@@ -45,6 +45,9 @@ func TestAccSFNStateMachine_createUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.include_execution_data", "false"),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.level", "OFF"),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.log_destination", ""),
+					resource.TestCheckResourceAttr(resourceName, "publish", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					resource.TestCheckResourceAttr(resourceName, "state_machine_version_arn", ""),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tracing_configuration.0.enabled", "false"),
@@ -55,6 +58,10 @@ func TestAccSFNStateMachine_createUpdate(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"publish",
+					"version_description",
+				},
 			},
 			{
 				Config: testAccStateMachineConfig_basic(rName, 10),
@@ -186,6 +193,10 @@ func TestAccSFNStateMachine_nameGenerated(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"publish",
+					"version_description",
+				},
 			},
 		},
 	})
@@ -215,6 +226,10 @@ func TestAccSFNStateMachine_namePrefix(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"publish",
+					"version_description",
+				},
 			},
 		},
 	})
@@ -244,6 +259,10 @@ func TestAccSFNStateMachine_tags(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"publish",
+					"version_description",
+				},
 			},
 			{
 				Config: testAccStateMachineConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -290,6 +309,10 @@ func TestAccSFNStateMachine_tracing(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"publish",
+					"version_description",
+				},
 			},
 			{
 				Config: testAccStateMachineConfig_tracingEnable(rName),
@@ -370,6 +393,50 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_publish(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_publish(rName, 5, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "first"),
+				),
+			},
+			{
+				Config: testAccStateMachineConfig_publish(rName, 10, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "second"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"publish",
+					"version_description",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -843,3 +910,38 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_publish(rName string, rMaxAttempts int, versionDescription string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                = %[1]q
+  role_arn            = aws_iam_role.for_sfn.arn
+  publish             = true
+  version_description = %[3]q
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": %[2]d,
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, rMaxAttempts, versionDescription))
+}
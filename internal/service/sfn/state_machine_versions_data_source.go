@@ -0,0 +1,62 @@
+package sfn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceStateMachineVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"statemachine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"statemachine_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SFNConn()
+
+	stateMachineARN := d.Get("statemachine_arn").(string)
+	input := &sfn.ListStateMachineVersionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+	var arns []string
+
+	err := listStateMachineVersionsPages(ctx, conn, input, func(page *sfn.ListStateMachineVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StateMachineVersions {
+			arns = append(arns, aws.StringValue(v.StateMachineVersionArn))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return diag.Errorf("listing Step Functions State Machine (%s) Versions: %s", stateMachineARN, err)
+	}
+
+	d.SetId(stateMachineARN)
+	d.Set("statemachine_versions", arns)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package sfn_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSFNStateMachineVersionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_sfn_state_machine_versions.test"
+	resourceName := "aws_sfn_state_machine.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, sfn.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "statemachine_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "statemachine_versions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "state_machine_version_arn", dataSourceName, "statemachine_versions.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStateMachineVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_publish(rName, 5, "first"), `
+data "aws_sfn_state_machine_versions" "test" {
+  statemachine_arn = aws_sfn_state_machine.test.arn
+}
+`)
+}
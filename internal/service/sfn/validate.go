@@ -0,0 +1,404 @@
+package sfn
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"golang.org/x/exp/slices"
+)
+
+// Amazon States Language state types.
+// https://states-language.net/spec.html#state-type-table.
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+// choiceRuleComparisonOperators are the data-test expression operators allowed in a Choice Rule.
+// https://states-language.net/spec.html#choice-state.
+var choiceRuleComparisonOperators = []string{
+	"BooleanEquals", "BooleanEqualsPath",
+	"IsBoolean", "IsNull", "IsNumeric", "IsPresent", "IsString", "IsTimestamp",
+	"NumericEquals", "NumericEqualsPath",
+	"NumericGreaterThan", "NumericGreaterThanPath",
+	"NumericGreaterThanEquals", "NumericGreaterThanEqualsPath",
+	"NumericLessThan", "NumericLessThanPath",
+	"NumericLessThanEquals", "NumericLessThanEqualsPath",
+	"StringEquals", "StringEqualsPath",
+	"StringGreaterThan", "StringGreaterThanPath",
+	"StringGreaterThanEquals", "StringGreaterThanEqualsPath",
+	"StringLessThan", "StringLessThanPath",
+	"StringLessThanEquals", "StringLessThanEqualsPath",
+	"StringMatches",
+	"TimestampEquals", "TimestampEqualsPath",
+	"TimestampGreaterThan", "TimestampGreaterThanPath",
+	"TimestampGreaterThanEquals", "TimestampGreaterThanEqualsPath",
+	"TimestampLessThan", "TimestampLessThanPath",
+	"TimestampLessThanEquals", "TimestampLessThanEqualsPath",
+}
+
+// validStateMachineDefinition performs offline validation of an Amazon States Language
+// definition so that obviously broken state machines are reported at plan time.
+// Only structural problems are reported; anything the service would accept passes.
+func validStateMachineDefinition(v any, path cty.Path) diag.Diagnostics {
+	value, ok := v.(string)
+
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid value type",
+			Detail:        "Expected type to be string",
+			AttributePath: path,
+		}}
+	}
+
+	var definition map[string]any
+
+	if err := json.Unmarshal([]byte(value), &definition); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid Amazon States Language definition",
+			Detail:        fmt.Sprintf("definition is not a valid JSON object: %s", err),
+			AttributePath: path,
+		}}
+	}
+
+	validator := &aslValidator{}
+	validator.validateStateMachine("", definition)
+
+	if len(validator.problems) == 0 {
+		return nil
+	}
+
+	// All problems are in the same attribute, so they are reported together, one per line.
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Invalid Amazon States Language definition",
+		Detail:        strings.Join(validator.problems, "\n"),
+		AttributePath: path,
+	}}
+}
+
+type aslValidator struct {
+	problems []string
+}
+
+// errorf records a problem at location, the dot-separated path of the offending field within the definition.
+func (v *aslValidator) errorf(location, format string, a ...any) {
+	v.problems = append(v.problems, fmt.Sprintf("%s: %s", location, fmt.Sprintf(format, a...)))
+}
+
+// validateStateMachine validates a top-level state machine or a Parallel branch or Map processor,
+// which share the same StartAt/States structure. prefix locates the machine within the definition.
+func (v *aslValidator) validateStateMachine(prefix string, machine map[string]any) {
+	statesPrefix := joinLocation(prefix, "States")
+	states, ok := machine["States"].(map[string]any)
+
+	if !ok {
+		v.errorf(statesPrefix, "must be an object")
+		return
+	}
+
+	if len(states) == 0 {
+		v.errorf(statesPrefix, "must contain at least one state")
+		return
+	}
+
+	startAtPrefix := joinLocation(prefix, "StartAt")
+	startAt, ok := machine["StartAt"].(string)
+
+	switch {
+	case !ok || startAt == "":
+		v.errorf(startAtPrefix, "must be a non-empty string")
+	case states[startAt] == nil:
+		v.errorf(startAtPrefix, "state %q does not exist", startAt)
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	transitions := make(map[string][]string, len(states))
+
+	for _, name := range names {
+		location := joinLocation(statesPrefix, name)
+		state, ok := states[name].(map[string]any)
+
+		if !ok {
+			v.errorf(location, "state must be an object")
+			continue
+		}
+
+		targets := v.validateState(location, state)
+
+		for _, target := range targets {
+			if _, ok := states[target]; !ok {
+				v.errorf(location, "transition target %q does not exist", target)
+			}
+		}
+
+		transitions[name] = targets
+	}
+
+	if states[startAt] == nil {
+		return
+	}
+
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, target := range transitions[name] {
+			if _, ok := states[target]; ok && !reachable[target] {
+				reachable[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(joinLocation(statesPrefix, name), "state is unreachable from StartAt %q", startAt)
+		}
+	}
+}
+
+// validateState validates a single state and returns the names of the states it can transition to.
+func (v *aslValidator) validateState(location string, state map[string]any) []string {
+	stateType, ok := state["Type"].(string)
+
+	if !ok {
+		v.errorf(location, "Type must be a string")
+		return nil
+	}
+
+	if !slices.Contains(stateType_Values(), stateType) {
+		v.errorf(location, "Type %q is not one of %s", stateType, strings.Join(stateType_Values(), ", "))
+		return nil
+	}
+
+	var targets []string
+	next, hasNext := state["Next"]
+	end, _ := state["End"].(bool)
+
+	switch stateType {
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		if hasNext {
+			v.errorf(location, "%s state must not have Next", stateType)
+		}
+		if end {
+			v.errorf(location, "%s state must not have End", stateType)
+		}
+	default:
+		switch {
+		case hasNext && end:
+			v.errorf(location, "state must not have both Next and End")
+		case !hasNext && !end:
+			v.errorf(location, "state must have either Next or End set to true")
+		case hasNext:
+			if next, ok := next.(string); ok && next != "" {
+				targets = append(targets, next)
+			} else {
+				v.errorf(location, "Next must be a non-empty string")
+			}
+		}
+	}
+
+	switch stateType {
+	case stateTypeChoice:
+		targets = append(targets, v.validateChoiceState(location, state)...)
+	case stateTypeWait:
+		n := 0
+		for _, k := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[k]; ok {
+				n++
+			}
+		}
+		if n != 1 {
+			v.errorf(location, "Wait state must have exactly one of Seconds, SecondsPath, Timestamp or TimestampPath")
+		}
+	case stateTypeParallel:
+		branches, ok := state["Branches"].([]any)
+
+		if !ok || len(branches) == 0 {
+			v.errorf(location, "Parallel state must have a non-empty Branches array")
+		}
+
+		for i, branch := range branches {
+			branchLocation := fmt.Sprintf("%s.Branches[%d]", location, i)
+
+			if branch, ok := branch.(map[string]any); ok {
+				v.validateStateMachine(branchLocation, branch)
+			} else {
+				v.errorf(branchLocation, "branch must be an object")
+			}
+		}
+	case stateTypeMap:
+		var found bool
+
+		for _, k := range []string{"ItemProcessor", "Iterator"} {
+			if processor, ok := state[k]; ok {
+				found = true
+
+				if processor, ok := processor.(map[string]any); ok {
+					v.validateStateMachine(joinLocation(location, k), processor)
+				} else {
+					v.errorf(joinLocation(location, k), "%s must be an object", k)
+				}
+			}
+		}
+
+		if !found {
+			v.errorf(location, "Map state must have an ItemProcessor or Iterator")
+		}
+	}
+
+	if catchers, ok := state["Catch"].([]any); ok {
+		for i, catcher := range catchers {
+			catcherLocation := fmt.Sprintf("%s.Catch[%d]", location, i)
+			catcher, ok := catcher.(map[string]any)
+
+			if !ok {
+				v.errorf(catcherLocation, "catcher must be an object")
+				continue
+			}
+
+			if next, ok := catcher["Next"].(string); ok && next != "" {
+				targets = append(targets, next)
+			} else {
+				v.errorf(catcherLocation, "Next must be a non-empty string")
+			}
+		}
+	}
+
+	return targets
+}
+
+func (v *aslValidator) validateChoiceState(location string, state map[string]any) []string {
+	var targets []string
+
+	choices, ok := state["Choices"].([]any)
+
+	if !ok || len(choices) == 0 {
+		v.errorf(location, "Choice state must have a non-empty Choices array")
+	}
+
+	for i, rule := range choices {
+		ruleLocation := fmt.Sprintf("%s.Choices[%d]", location, i)
+		rule, ok := rule.(map[string]any)
+
+		if !ok {
+			v.errorf(ruleLocation, "Choice Rule must be an object")
+			continue
+		}
+
+		if next, ok := rule["Next"].(string); ok && next != "" {
+			targets = append(targets, next)
+		} else {
+			v.errorf(ruleLocation, "top-level Choice Rule must have a non-empty Next")
+		}
+
+		v.validateChoiceRule(ruleLocation, rule)
+	}
+
+	if v, ok := state["Default"].(string); ok && v != "" {
+		targets = append(targets, v)
+	}
+
+	return targets
+}
+
+// validateChoiceRule validates the expression of a Choice Rule: a boolean combination
+// (And, Or, Not), a data-test expression (Variable plus one comparison operator)
+// or a JSONata Condition.
+func (v *aslValidator) validateChoiceRule(location string, rule map[string]any) {
+	var operators []string
+
+	for _, k := range []string{"And", "Condition", "Not", "Or"} {
+		if _, ok := rule[k]; ok {
+			operators = append(operators, k)
+		}
+	}
+	for _, k := range choiceRuleComparisonOperators {
+		if _, ok := rule[k]; ok {
+			operators = append(operators, k)
+		}
+	}
+
+	if len(operators) != 1 {
+		v.errorf(location, "Choice Rule must have exactly one of And, Or, Not, Condition or a comparison operator, found %d", len(operators))
+		return
+	}
+
+	switch operator := operators[0]; operator {
+	case "And", "Or":
+		rules, ok := rule[operator].([]any)
+
+		if !ok || len(rules) == 0 {
+			v.errorf(location, "%s must be a non-empty array of Choice Rules", operator)
+			return
+		}
+
+		for i, nested := range rules {
+			v.validateNestedChoiceRule(fmt.Sprintf("%s.%s[%d]", location, operator, i), nested)
+		}
+	case "Not":
+		v.validateNestedChoiceRule(joinLocation(location, operator), rule[operator])
+	case "Condition":
+	default:
+		if variable, ok := rule["Variable"].(string); !ok || variable == "" {
+			v.errorf(location, "Choice Rule using %s must have a non-empty Variable", operator)
+		}
+	}
+}
+
+func (v *aslValidator) validateNestedChoiceRule(location string, rule any) {
+	nested, ok := rule.(map[string]any)
+
+	if !ok {
+		v.errorf(location, "Choice Rule must be an object")
+		return
+	}
+
+	if _, ok := nested["Next"]; ok {
+		v.errorf(location, "nested Choice Rule must not have Next")
+	}
+
+	v.validateChoiceRule(location, nested)
+}
+
+func joinLocation(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
@@ -0,0 +1,177 @@
+package sfn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidStateMachineDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		errors     []string
+	}{
+		"valid task": {
+			definition: `{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-east-1:123456789012:function:test",
+      "End": true
+    }
+  }
+}`,
+		},
+		"valid choice": {
+			definition: `{
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "Variable": "$.value",
+          "NumericGreaterThan": 10,
+          "Next": "Big"
+        },
+        {
+          "And": [
+            {"Variable": "$.value", "IsPresent": true},
+            {"Not": {"Variable": "$.value", "NumericEquals": 0}}
+          ],
+          "Next": "Small"
+        }
+      ],
+      "Default": "Done"
+    },
+    "Big": {"Type": "Pass", "Next": "Done"},
+    "Small": {"Type": "Wait", "Seconds": 5, "Next": "Done"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"valid parallel and map": {
+			definition: `{
+  "StartAt": "Fan",
+  "States": {
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}
+      ],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemProcessor": {"StartAt": "B", "States": {"B": {"Type": "Pass", "End": true}}},
+      "End": true
+    },
+    "Failed": {"Type": "Fail"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition: `{"StartAt": `,
+			errors:     []string{"not a valid JSON object"},
+		},
+		"missing StartAt target": {
+			definition: `{"StartAt": "Nope", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			errors: []string{
+				`StartAt: state "Nope" does not exist`,
+			},
+		},
+		"unknown state type": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Lambda", "End": true}}}`,
+			errors:     []string{`States.A: Type "Lambda" is not one of`},
+		},
+		"missing Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			errors:     []string{"States.A: state must have either Next or End set to true"},
+		},
+		"both Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			errors: []string{
+				"States.A: state must not have both Next and End",
+				`States.B: state is unreachable from StartAt "A"`,
+			},
+		},
+		"terminal state with Next": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "Next": "A"}}}`,
+			errors:     []string{"States.A: Succeed state must not have Next"},
+		},
+		"missing Next target": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+			errors:     []string{`States.A: transition target "B" does not exist`},
+		},
+		"unreachable state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}, "B": {"Type": "Pass", "End": true}}}`,
+			errors:     []string{`States.B: state is unreachable from StartAt "A"`},
+		},
+		"choice rule without Next": {
+			definition: `{"StartAt": "C", "States": {"C": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsNull": true}], "Default": "D"}, "D": {"Type": "Succeed"}}}`,
+			errors:     []string{"States.C.Choices[0]: top-level Choice Rule must have a non-empty Next"},
+		},
+		"choice rule with two operators": {
+			definition: `{"StartAt": "C", "States": {"C": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsNull": true, "IsString": true, "Next": "D"}]}, "D": {"Type": "Succeed"}}}`,
+			errors:     []string{"States.C.Choices[0]: Choice Rule must have exactly one of"},
+		},
+		"choice rule without Variable": {
+			definition: `{"StartAt": "C", "States": {"C": {"Type": "Choice", "Choices": [{"StringEquals": "x", "Next": "D"}]}, "D": {"Type": "Succeed"}}}`,
+			errors:     []string{"States.C.Choices[0]: Choice Rule using StringEquals must have a non-empty Variable"},
+		},
+		"nested choice rule with Next": {
+			definition: `{"StartAt": "C", "States": {"C": {"Type": "Choice", "Choices": [{"Not": {"Variable": "$.x", "IsNull": true, "Next": "D"}, "Next": "D"}]}, "D": {"Type": "Succeed"}}}`,
+			errors:     []string{"States.C.Choices[0].Not: nested Choice Rule must not have Next"},
+		},
+		"invalid parallel branch": {
+			definition: `{"StartAt": "P", "States": {"P": {"Type": "Parallel", "Branches": [{"StartAt": "X", "States": {"A": {"Type": "Pass", "End": true}}}], "End": true}}}`,
+			errors: []string{
+				`States.P.Branches[0].StartAt: state "X" does not exist`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := cty.GetAttrPath("definition")
+			diags := validStateMachineDefinition(testCase.definition, path)
+
+			if len(testCase.errors) == 0 {
+				if len(diags) != 0 {
+					t.Fatalf("got unexpected diagnostics: %v", diags)
+				}
+
+				return
+			}
+
+			if got, want := len(diags), 1; got != want {
+				t.Fatalf("got %d diagnostics, want %d: %v", got, want, diags)
+			}
+
+			detail := diags[0].Detail
+
+			if got, want := len(strings.Split(detail, "\n")), len(testCase.errors); got != want {
+				t.Errorf("got %d problems, want %d: %q", got, want, detail)
+			}
+
+			for _, want := range testCase.errors {
+				if !strings.Contains(detail, want) {
+					t.Errorf("got %q, want it to contain %q", detail, want)
+				}
+			}
+
+			if !diags[0].AttributePath.Equals(path) {
+				t.Errorf("got attribute path %#v, want %#v", diags[0].AttributePath, path)
+			}
+		})
+	}
+}
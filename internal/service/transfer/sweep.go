@@ -15,6 +15,28 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_transfer_certificate", &resource.Sweeper{
+		Name: "aws_transfer_certificate",
+		F:    sweepCertificates,
+		Dependencies: []string{
+			"aws_transfer_profile",
+		},
+	})
+
+	resource.AddTestSweepers("aws_transfer_connector", &resource.Sweeper{
+		Name: "aws_transfer_connector",
+		F:    sweepConnectors,
+	})
+
+	resource.AddTestSweepers("aws_transfer_profile", &resource.Sweeper{
+		Name: "aws_transfer_profile",
+		F:    sweepProfiles,
+		Dependencies: []string{
+			"aws_transfer_connector",
+			"aws_transfer_server",
+		},
+	})
+
 	resource.AddTestSweepers("aws_transfer_server", &resource.Sweeper{
 		Name: "aws_transfer_server",
 		F:    sweepServers,
@@ -118,3 +140,135 @@ func sweepWorkflows(region string) error {
 
 	return nil
 }
+
+func sweepCertificates(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListCertificatesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListCertificatesPagesWithContext(ctx, input, func(page *transfer.ListCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Certificates {
+			r := ResourceCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CertificateId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Certificate sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Certificates (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Certificates (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepConnectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListConnectorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListConnectorsPagesWithContext(ctx, input, func(page *transfer.ListConnectorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connectors {
+			r := ResourceConnector()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ConnectorId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Connector sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Connectors (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Connectors (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepProfiles(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListProfilesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = conn.ListProfilesPagesWithContext(ctx, input, func(page *transfer.ListProfilesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Profiles {
+			r := ResourceProfile()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.ProfileId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Profile sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Profiles (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Profiles (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceAccessLogSubscription)
+}
+
+func newResourceAccessLogSubscription(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAccessLogSubscription{}, nil
+}
+
+type resourceAccessLogSubscription struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAccessLogSubscription) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_access_log_subscription"
+}
+
+func (r *resourceAccessLogSubscription) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"destination_arn": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"resource_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+const (
+	ResNameAccessLogSubscription = "Access Log Subscription"
+)
+
+func (r *resourceAccessLogSubscription) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAccessLogSubscriptionData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	resourceID := data.ResourceIdentifier.ValueString()
+	input := &vpclattice.CreateAccessLogSubscriptionInput{
+		ClientToken:        aws.String(sdkresource.UniqueId()),
+		DestinationArn:     flex.StringFromFramework(ctx, data.DestinationARN),
+		ResourceIdentifier: aws.String(resourceID),
+	}
+
+	tags := r.ExpandTags(ctx, data.Tags)
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateAccessLogSubscription(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameAccessLogSubscription, resourceID, nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.ID = flex.StringToFramework(ctx, output.Id)
+	data.ResourceARN = flex.StringToFramework(ctx, output.ResourceArn)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAccessLogSubscription) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAccessLogSubscriptionData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	output, err := FindAccessLogSubscriptionByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameAccessLogSubscription, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.DestinationARN = flex.StringToFramework(ctx, output.DestinationArn)
+	data.ResourceARN = flex.StringToFramework(ctx, output.ResourceArn)
+	data.ResourceIdentifier = flex.StringToFramework(ctx, output.ResourceId)
+
+	tags, err := ListTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameAccessLogSubscription, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Tags = r.FlattenTags(ctx, tags)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAccessLogSubscription) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceAccessLogSubscriptionData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	if !new.DestinationARN.Equal(old.DestinationARN) {
+		input := &vpclattice.UpdateAccessLogSubscriptionInput{
+			AccessLogSubscriptionIdentifier: flex.StringFromFramework(ctx, new.ID),
+			DestinationArn:                  flex.StringFromFramework(ctx, new.DestinationARN),
+		}
+
+		_, err := conn.UpdateAccessLogSubscription(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameAccessLogSubscription, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTags(ctx, conn, new.ARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameAccessLogSubscription, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceAccessLogSubscription) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAccessLogSubscriptionData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	tflog.Debug(ctx, "deleting VPC Lattice Access Log Subscription", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteAccessLogSubscription(ctx, &vpclattice.DeleteAccessLogSubscriptionInput{
+		AccessLogSubscriptionIdentifier: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameAccessLogSubscription, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceAccessLogSubscription) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceAccessLogSubscription) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceAccessLogSubscriptionData struct {
+	ARN                types.String `tfsdk:"arn"`
+	DestinationARN     types.String `tfsdk:"destination_arn"`
+	ID                 types.String `tfsdk:"id"`
+	ResourceARN        types.String `tfsdk:"resource_arn"`
+	ResourceIdentifier types.String `tfsdk:"resource_identifier"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+}
@@ -0,0 +1,226 @@
+package vpclattice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceAuthPolicy)
+}
+
+func newResourceAuthPolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAuthPolicy{}, nil
+}
+
+type resourceAuthPolicy struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceAuthPolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_auth_policy"
+}
+
+func (r *resourceAuthPolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"policy": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.JSON(),
+				},
+			},
+			"resource_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameAuthPolicy = "Auth Policy"
+)
+
+func (r *resourceAuthPolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceAuthPolicyData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	resourceID := data.ResourceIdentifier.ValueString()
+	output, err := putAuthPolicy(ctx, conn, resourceID, data.Policy.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameAuthPolicy, resourceID, nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(resourceID)
+	data.State = flex.StringValueToFramework(ctx, output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAuthPolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceAuthPolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	output, err := FindAuthPolicyByResourceIdentifier(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameAuthPolicy, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	// Keep the configured policy document if the remote one is equivalent.
+	policy, err := verify.SecondJSONUnlessEquivalent(data.Policy.ValueString(), aws.ToString(output.Policy))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameAuthPolicy, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Policy = types.StringValue(policy)
+	data.ResourceIdentifier = data.ID
+	data.State = flex.StringValueToFramework(ctx, output.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceAuthPolicy) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceAuthPolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	if !new.Policy.Equal(old.Policy) {
+		if _, err := putAuthPolicy(ctx, conn, new.ID.ValueString(), new.Policy.ValueString()); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameAuthPolicy, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceAuthPolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceAuthPolicyData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	tflog.Debug(ctx, "deleting VPC Lattice Auth Policy", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteAuthPolicy(ctx, &vpclattice.DeleteAuthPolicyInput{
+		ResourceIdentifier: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameAuthPolicy, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceAuthPolicy) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+type resourceAuthPolicyData struct {
+	ID                 types.String `tfsdk:"id"`
+	Policy             types.String `tfsdk:"policy"`
+	ResourceIdentifier types.String `tfsdk:"resource_identifier"`
+	State              types.String `tfsdk:"state"`
+}
+
+func putAuthPolicy(ctx context.Context, conn *vpclattice.Client, resourceID, policy string) (*vpclattice.PutAuthPolicyOutput, error) {
+	normalizedPolicy, err := structure.NormalizeJsonString(policy)
+
+	if err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	input := &vpclattice.PutAuthPolicyInput{
+		Policy:             aws.String(normalizedPolicy),
+		ResourceIdentifier: aws.String(resourceID),
+	}
+
+	return conn.PutAuthPolicy(ctx, input)
+}
@@ -0,0 +1,15 @@
+package vpclattice
+
+// Exports for use in tests only.
+var (
+	ResourceAccessLogSubscription            = newResourceAccessLogSubscription
+	ResourceAuthPolicy                       = newResourceAuthPolicy
+	ResourceListener                         = newResourceListener
+	ResourceListenerRule                     = newResourceListenerRule
+	ResourceService                          = newResourceService
+	ResourceServiceNetwork                   = newResourceServiceNetwork
+	ResourceServiceNetworkServiceAssociation = newResourceServiceNetworkServiceAssociation
+	ResourceServiceNetworkVPCAssociation     = newResourceServiceNetworkVPCAssociation
+	ResourceTargetGroup                      = newResourceTargetGroup
+	ResourceTargetGroupAttachment            = newResourceTargetGroupAttachment
+)
@@ -0,0 +1,580 @@
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwint64planmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/int64planmodifier"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceListener)
+}
+
+func newResourceListener(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceListener{}, nil
+}
+
+type resourceListener struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceListener) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_listener"
+}
+
+func (r *resourceListener) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"listener_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.ListenerProtocol](),
+				},
+			},
+			"service_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"default_action": ruleActionBlock(),
+		},
+	}
+}
+
+const (
+	ResNameListener = "Listener"
+
+	listenerIDPartCount = 2
+)
+
+func (r *resourceListener) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceListenerData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	name := data.Name.ValueString()
+	defaultAction, diags := expandRuleAction(ctx, data.DefaultAction)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &vpclattice.CreateListenerInput{
+		ClientToken:       aws.String(sdkresource.UniqueId()),
+		DefaultAction:     defaultAction,
+		Name:              aws.String(name),
+		Port:              flex.Int32FromFramework(ctx, data.Port),
+		Protocol:          awstypes.ListenerProtocol(data.Protocol.ValueString()),
+		ServiceIdentifier: flex.StringFromFramework(ctx, data.ServiceIdentifier),
+	}
+
+	tags := r.ExpandTags(ctx, data.Tags)
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateListener(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameListener, name, nil), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(output.ServiceId), aws.ToString(output.Id)}, listenerIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionFlatteningResourceId, ResNameListener, name, nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.ID = types.StringValue(id)
+	data.ListenerID = flex.StringToFramework(ctx, output.Id)
+	data.Port = flex.Int32ToFramework(ctx, output.Port)
+	data.ServiceARN = flex.StringToFramework(ctx, output.ServiceArn)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceListener) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceListenerData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), listenerIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameListener, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	serviceID, listenerID := parts[0], parts[1]
+	output, err := FindListenerByTwoPartKey(ctx, conn, serviceID, listenerID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameListener, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	defaultAction, diags := flattenRuleAction(ctx, output.DefaultAction)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.DefaultAction = defaultAction
+	data.ListenerID = flex.StringToFramework(ctx, output.Id)
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.Port = flex.Int32ToFramework(ctx, output.Port)
+	data.Protocol = flex.StringValueToFramework(ctx, output.Protocol)
+	data.ServiceARN = flex.StringToFramework(ctx, output.ServiceArn)
+	data.ServiceIdentifier = flex.StringToFramework(ctx, output.ServiceId)
+
+	tags, err := ListTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameListener, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Tags = r.FlattenTags(ctx, tags)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceListener) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceListenerData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	if !new.DefaultAction.Equal(old.DefaultAction) {
+		parts, err := flex.ExpandResourceId(new.ID.ValueString(), listenerIDPartCount)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameListener, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+
+		defaultAction, diags := expandRuleAction(ctx, new.DefaultAction)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &vpclattice.UpdateListenerInput{
+			DefaultAction:      defaultAction,
+			ListenerIdentifier: aws.String(parts[1]),
+			ServiceIdentifier:  aws.String(parts[0]),
+		}
+
+		_, err = conn.UpdateListener(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameListener, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTags(ctx, conn, new.ARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameListener, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceListener) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceListenerData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), listenerIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameListener, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	tflog.Debug(ctx, "deleting VPC Lattice Listener", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err = conn.DeleteListener(ctx, &vpclattice.DeleteListenerInput{
+		ListenerIdentifier: aws.String(parts[1]),
+		ServiceIdentifier:  aws.String(parts[0]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameListener, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceListener) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceListener) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceListenerData struct {
+	ARN               types.String `tfsdk:"arn"`
+	DefaultAction     types.List   `tfsdk:"default_action"`
+	ID                types.String `tfsdk:"id"`
+	ListenerID        types.String `tfsdk:"listener_id"`
+	Name              types.String `tfsdk:"name"`
+	Port              types.Int64  `tfsdk:"port"`
+	Protocol          types.String `tfsdk:"protocol"`
+	ServiceARN        types.String `tfsdk:"service_arn"`
+	ServiceIdentifier types.String `tfsdk:"service_identifier"`
+	Tags              types.Map    `tfsdk:"tags"`
+	TagsAll           types.Map    `tfsdk:"tags_all"`
+}
+
+func ruleActionBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"fixed_response": schema.ListNestedBlock{
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"status_code": schema.Int64Attribute{
+								Required: true,
+								Validators: []validator.Int64{
+									int64validator.Between(100, 599),
+								},
+							},
+						},
+					},
+				},
+				"forward": schema.ListNestedBlock{
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"target_groups": schema.ListNestedBlock{
+								Validators: []validator.List{
+									listvalidator.IsRequired(),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.SizeAtMost(10),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"target_group_identifier": schema.StringAttribute{
+											Required: true,
+										},
+										"weight": schema.Int64Attribute{
+											Optional: true,
+											Computed: true,
+											PlanModifiers: []planmodifier.Int64{
+												fwint64planmodifier.DefaultValue(100),
+											},
+											Validators: []validator.Int64{
+												int64validator.Between(0, 999),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var (
+	ruleActionAttrTypes = map[string]attr.Type{
+		"fixed_response": types.ListType{ElemType: types.ObjectType{AttrTypes: fixedResponseActionAttrTypes}},
+		"forward":        types.ListType{ElemType: types.ObjectType{AttrTypes: forwardActionAttrTypes}},
+	}
+
+	fixedResponseActionAttrTypes = map[string]attr.Type{
+		"status_code": types.Int64Type,
+	}
+
+	forwardActionAttrTypes = map[string]attr.Type{
+		"target_groups": types.ListType{ElemType: types.ObjectType{AttrTypes: weightedTargetGroupAttrTypes}},
+	}
+
+	weightedTargetGroupAttrTypes = map[string]attr.Type{
+		"target_group_identifier": types.StringType,
+		"weight":                  types.Int64Type,
+	}
+)
+
+type ruleActionData struct {
+	FixedResponse types.List `tfsdk:"fixed_response"`
+	Forward       types.List `tfsdk:"forward"`
+}
+
+type fixedResponseActionData struct {
+	StatusCode types.Int64 `tfsdk:"status_code"`
+}
+
+type forwardActionData struct {
+	TargetGroups types.List `tfsdk:"target_groups"`
+}
+
+type weightedTargetGroupData struct {
+	TargetGroupIdentifier types.String `tfsdk:"target_group_identifier"`
+	Weight                types.Int64  `tfsdk:"weight"`
+}
+
+func expandRuleAction(ctx context.Context, tfList types.List) (awstypes.RuleAction, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []ruleActionData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	tfObject := data[0]
+
+	var fixedResponse []fixedResponseActionData
+	diags.Append(tfObject.FixedResponse.ElementsAs(ctx, &fixedResponse, false)...)
+
+	if len(fixedResponse) > 0 {
+		return &awstypes.RuleActionMemberFixedResponse{
+			Value: awstypes.FixedResponseAction{
+				StatusCode: flex.Int32FromFramework(ctx, fixedResponse[0].StatusCode),
+			},
+		}, diags
+	}
+
+	var forward []forwardActionData
+	diags.Append(tfObject.Forward.ElementsAs(ctx, &forward, false)...)
+
+	if len(forward) > 0 {
+		var targetGroups []weightedTargetGroupData
+		diags.Append(forward[0].TargetGroups.ElementsAs(ctx, &targetGroups, false)...)
+
+		return &awstypes.RuleActionMemberForward{
+			Value: awstypes.ForwardAction{
+				TargetGroups: expandWeightedTargetGroups(ctx, targetGroups),
+			},
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func expandWeightedTargetGroups(ctx context.Context, tfList []weightedTargetGroupData) []awstypes.WeightedTargetGroup {
+	var apiObjects []awstypes.WeightedTargetGroup
+
+	for _, tfObject := range tfList {
+		apiObjects = append(apiObjects, awstypes.WeightedTargetGroup{
+			TargetGroupIdentifier: flex.StringFromFramework(ctx, tfObject.TargetGroupIdentifier),
+			Weight:                flex.Int32FromFramework(ctx, tfObject.Weight),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRuleAction(ctx context.Context, apiObject awstypes.RuleAction) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: ruleActionAttrTypes}
+
+	fixedResponse := types.ListValueMust(types.ObjectType{AttrTypes: fixedResponseActionAttrTypes}, []attr.Value{})
+	forward := types.ListValueMust(types.ObjectType{AttrTypes: forwardActionAttrTypes}, []attr.Value{})
+
+	switch v := apiObject.(type) {
+	case *awstypes.RuleActionMemberFixedResponse:
+		obj := map[string]attr.Value{
+			"status_code": flex.Int32ToFramework(ctx, v.Value.StatusCode),
+		}
+		objVal, d := types.ObjectValue(fixedResponseActionAttrTypes, obj)
+		diags.Append(d...)
+
+		fixedResponse, d = types.ListValue(types.ObjectType{AttrTypes: fixedResponseActionAttrTypes}, []attr.Value{objVal})
+		diags.Append(d...)
+	case *awstypes.RuleActionMemberForward:
+		targetGroups, d := flattenWeightedTargetGroups(ctx, v.Value.TargetGroups)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"target_groups": targetGroups,
+		}
+		objVal, d := types.ObjectValue(forwardActionAttrTypes, obj)
+		diags.Append(d...)
+
+		forward, d = types.ListValue(types.ObjectType{AttrTypes: forwardActionAttrTypes}, []attr.Value{objVal})
+		diags.Append(d...)
+	}
+
+	obj := map[string]attr.Value{
+		"fixed_response": fixedResponse,
+		"forward":        forward,
+	}
+	objVal, d := types.ObjectValue(ruleActionAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenWeightedTargetGroups(ctx context.Context, apiObjects []awstypes.WeightedTargetGroup) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: weightedTargetGroupAttrTypes}
+
+	elems := []attr.Value{}
+	for _, apiObject := range apiObjects {
+		obj := map[string]attr.Value{
+			"target_group_identifier": flex.StringToFramework(ctx, apiObject.TargetGroupIdentifier),
+			"weight":                  flex.Int32ToFramework(ctx, apiObject.Weight),
+		}
+		objVal, d := types.ObjectValue(weightedTargetGroupAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -0,0 +1,784 @@
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwboolplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/boolplanmodifier"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceListenerRule)
+}
+
+func newResourceListenerRule(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceListenerRule{}, nil
+}
+
+type resourceListenerRule struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceListenerRule) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_listener_rule"
+}
+
+func (r *resourceListenerRule) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	caseSensitiveAttribute := schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			fwboolplanmodifier.DefaultValue(false),
+		},
+	}
+	matchValueAttribute := schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 128),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"listener_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"rule_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"action": ruleActionBlock(),
+			"match": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"http_match": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"method": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"header_matches": schema.ListNestedBlock{
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(5),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"case_sensitive": caseSensitiveAttribute,
+												"name": schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 40),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"match": schema.ListNestedBlock{
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"contains": matchValueAttribute,
+															"exact":    matchValueAttribute,
+															"prefix":   matchValueAttribute,
+														},
+													},
+												},
+											},
+										},
+									},
+									"path_match": schema.ListNestedBlock{
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"case_sensitive": caseSensitiveAttribute,
+											},
+											Blocks: map[string]schema.Block{
+												"match": schema.ListNestedBlock{
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"exact":  matchValueAttribute,
+															"prefix": matchValueAttribute,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	ResNameListenerRule = "Listener Rule"
+
+	listenerRuleIDPartCount = 3
+)
+
+func (r *resourceListenerRule) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceListenerRuleData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	action, diags := expandRuleAction(ctx, data.Action)
+	response.Diagnostics.Append(diags...)
+
+	match, diags := expandRuleMatch(ctx, data.Match)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	serviceID := data.ServiceIdentifier.ValueString()
+	listenerID := data.ListenerIdentifier.ValueString()
+	input := &vpclattice.CreateRuleInput{
+		Action:             action,
+		ClientToken:        aws.String(sdkresource.UniqueId()),
+		ListenerIdentifier: aws.String(listenerID),
+		Match:              match,
+		Name:               aws.String(name),
+		Priority:           flex.Int32FromFramework(ctx, data.Priority),
+		ServiceIdentifier:  aws.String(serviceID),
+	}
+
+	tags := r.ExpandTags(ctx, data.Tags)
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateRule(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameListenerRule, name, nil), err.Error())
+
+		return
+	}
+
+	id, err := flex.FlattenResourceId([]string{serviceID, listenerID, aws.ToString(output.Id)}, listenerRuleIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionFlatteningResourceId, ResNameListenerRule, name, nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.ID = types.StringValue(id)
+	data.RuleID = flex.StringToFramework(ctx, output.Id)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceListenerRule) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceListenerRuleData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), listenerRuleIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameListenerRule, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	serviceID, listenerID, ruleID := parts[0], parts[1], parts[2]
+	output, err := FindListenerRuleByThreePartKey(ctx, conn, serviceID, listenerID, ruleID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameListenerRule, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	action, diags := flattenRuleAction(ctx, output.Action)
+	response.Diagnostics.Append(diags...)
+
+	match, diags := flattenRuleMatch(ctx, output.Match)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Action = action
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.ListenerIdentifier = types.StringValue(listenerID)
+	data.Match = match
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.Priority = flex.Int32ToFramework(ctx, output.Priority)
+	data.RuleID = flex.StringToFramework(ctx, output.Id)
+	data.ServiceIdentifier = types.StringValue(serviceID)
+
+	tags, err := ListTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameListenerRule, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Tags = r.FlattenTags(ctx, tags)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceListenerRule) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceListenerRuleData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	if !new.Action.Equal(old.Action) || !new.Match.Equal(old.Match) || !new.Priority.Equal(old.Priority) {
+		parts, err := flex.ExpandResourceId(new.ID.ValueString(), listenerRuleIDPartCount)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameListenerRule, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+
+		input := &vpclattice.UpdateRuleInput{
+			ListenerIdentifier: aws.String(parts[1]),
+			RuleIdentifier:     aws.String(parts[2]),
+			ServiceIdentifier:  aws.String(parts[0]),
+		}
+
+		if !new.Action.Equal(old.Action) {
+			action, diags := expandRuleAction(ctx, new.Action)
+			response.Diagnostics.Append(diags...)
+
+			input.Action = action
+		}
+
+		if !new.Match.Equal(old.Match) {
+			match, diags := expandRuleMatch(ctx, new.Match)
+			response.Diagnostics.Append(diags...)
+
+			input.Match = match
+		}
+
+		if !new.Priority.Equal(old.Priority) {
+			input.Priority = flex.Int32FromFramework(ctx, new.Priority)
+		}
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err = conn.UpdateRule(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameListenerRule, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTags(ctx, conn, new.ARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameListenerRule, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceListenerRule) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceListenerRuleData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), listenerRuleIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameListenerRule, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	tflog.Debug(ctx, "deleting VPC Lattice Listener Rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err = conn.DeleteRule(ctx, &vpclattice.DeleteRuleInput{
+		ListenerIdentifier: aws.String(parts[1]),
+		RuleIdentifier:     aws.String(parts[2]),
+		ServiceIdentifier:  aws.String(parts[0]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameListenerRule, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceListenerRule) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceListenerRule) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceListenerRuleData struct {
+	Action             types.List   `tfsdk:"action"`
+	ARN                types.String `tfsdk:"arn"`
+	ID                 types.String `tfsdk:"id"`
+	ListenerIdentifier types.String `tfsdk:"listener_identifier"`
+	Match              types.List   `tfsdk:"match"`
+	Name               types.String `tfsdk:"name"`
+	Priority           types.Int64  `tfsdk:"priority"`
+	RuleID             types.String `tfsdk:"rule_id"`
+	ServiceIdentifier  types.String `tfsdk:"service_identifier"`
+	Tags               types.Map    `tfsdk:"tags"`
+	TagsAll            types.Map    `tfsdk:"tags_all"`
+}
+
+var (
+	ruleMatchAttrTypes = map[string]attr.Type{
+		"http_match": types.ListType{ElemType: types.ObjectType{AttrTypes: httpMatchAttrTypes}},
+	}
+
+	httpMatchAttrTypes = map[string]attr.Type{
+		"header_matches": types.ListType{ElemType: types.ObjectType{AttrTypes: headerMatchAttrTypes}},
+		"method":         types.StringType,
+		"path_match":     types.ListType{ElemType: types.ObjectType{AttrTypes: pathMatchAttrTypes}},
+	}
+
+	headerMatchAttrTypes = map[string]attr.Type{
+		"case_sensitive": types.BoolType,
+		"match":          types.ListType{ElemType: types.ObjectType{AttrTypes: headerMatchTypeAttrTypes}},
+		"name":           types.StringType,
+	}
+
+	headerMatchTypeAttrTypes = map[string]attr.Type{
+		"contains": types.StringType,
+		"exact":    types.StringType,
+		"prefix":   types.StringType,
+	}
+
+	pathMatchAttrTypes = map[string]attr.Type{
+		"case_sensitive": types.BoolType,
+		"match":          types.ListType{ElemType: types.ObjectType{AttrTypes: pathMatchTypeAttrTypes}},
+	}
+
+	pathMatchTypeAttrTypes = map[string]attr.Type{
+		"exact":  types.StringType,
+		"prefix": types.StringType,
+	}
+)
+
+type ruleMatchData struct {
+	HTTPMatch types.List `tfsdk:"http_match"`
+}
+
+type httpMatchData struct {
+	HeaderMatches types.List   `tfsdk:"header_matches"`
+	Method        types.String `tfsdk:"method"`
+	PathMatch     types.List   `tfsdk:"path_match"`
+}
+
+type headerMatchData struct {
+	CaseSensitive types.Bool   `tfsdk:"case_sensitive"`
+	Match         types.List   `tfsdk:"match"`
+	Name          types.String `tfsdk:"name"`
+}
+
+type headerMatchTypeData struct {
+	Contains types.String `tfsdk:"contains"`
+	Exact    types.String `tfsdk:"exact"`
+	Prefix   types.String `tfsdk:"prefix"`
+}
+
+type pathMatchData struct {
+	CaseSensitive types.Bool `tfsdk:"case_sensitive"`
+	Match         types.List `tfsdk:"match"`
+}
+
+type pathMatchTypeData struct {
+	Exact  types.String `tfsdk:"exact"`
+	Prefix types.String `tfsdk:"prefix"`
+}
+
+func expandRuleMatch(ctx context.Context, tfList types.List) (awstypes.RuleMatch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []ruleMatchData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	var httpMatch []httpMatchData
+	diags.Append(data[0].HTTPMatch.ElementsAs(ctx, &httpMatch, false)...)
+
+	if diags.HasError() || len(httpMatch) == 0 {
+		return nil, diags
+	}
+
+	apiObject, d := expandHTTPMatch(ctx, httpMatch[0])
+	diags.Append(d...)
+
+	return &awstypes.RuleMatchMemberHttpMatch{
+		Value: apiObject,
+	}, diags
+}
+
+func expandHTTPMatch(ctx context.Context, tfObject httpMatchData) (awstypes.HttpMatch, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiObject := awstypes.HttpMatch{
+		Method: flex.StringFromFramework(ctx, tfObject.Method),
+	}
+
+	var headerMatches []headerMatchData
+	diags.Append(tfObject.HeaderMatches.ElementsAs(ctx, &headerMatches, false)...)
+
+	for _, headerMatch := range headerMatches {
+		var match []headerMatchTypeData
+		diags.Append(headerMatch.Match.ElementsAs(ctx, &match, false)...)
+
+		apiObject.HeaderMatches = append(apiObject.HeaderMatches, awstypes.HeaderMatch{
+			CaseSensitive: flex.BoolFromFramework(ctx, headerMatch.CaseSensitive),
+			Match:         expandHeaderMatchType(match),
+			Name:          flex.StringFromFramework(ctx, headerMatch.Name),
+		})
+	}
+
+	var pathMatch []pathMatchData
+	diags.Append(tfObject.PathMatch.ElementsAs(ctx, &pathMatch, false)...)
+
+	if len(pathMatch) > 0 {
+		var match []pathMatchTypeData
+		diags.Append(pathMatch[0].Match.ElementsAs(ctx, &match, false)...)
+
+		apiObject.PathMatch = &awstypes.PathMatch{
+			CaseSensitive: flex.BoolFromFramework(ctx, pathMatch[0].CaseSensitive),
+			Match:         expandPathMatchType(match),
+		}
+	}
+
+	return apiObject, diags
+}
+
+func expandHeaderMatchType(tfList []headerMatchTypeData) awstypes.HeaderMatchType {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	tfObject := tfList[0]
+
+	if v := tfObject.Contains.ValueString(); v != "" {
+		return &awstypes.HeaderMatchTypeMemberContains{Value: v}
+	}
+
+	if v := tfObject.Exact.ValueString(); v != "" {
+		return &awstypes.HeaderMatchTypeMemberExact{Value: v}
+	}
+
+	if v := tfObject.Prefix.ValueString(); v != "" {
+		return &awstypes.HeaderMatchTypeMemberPrefix{Value: v}
+	}
+
+	return nil
+}
+
+func expandPathMatchType(tfList []pathMatchTypeData) awstypes.PathMatchType {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	tfObject := tfList[0]
+
+	if v := tfObject.Exact.ValueString(); v != "" {
+		return &awstypes.PathMatchTypeMemberExact{Value: v}
+	}
+
+	if v := tfObject.Prefix.ValueString(); v != "" {
+		return &awstypes.PathMatchTypeMemberPrefix{Value: v}
+	}
+
+	return nil
+}
+
+func flattenRuleMatch(ctx context.Context, apiObject awstypes.RuleMatch) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: ruleMatchAttrTypes}
+
+	httpMatch := types.ListValueMust(types.ObjectType{AttrTypes: httpMatchAttrTypes}, []attr.Value{})
+
+	if v, ok := apiObject.(*awstypes.RuleMatchMemberHttpMatch); ok {
+		var d diag.Diagnostics
+		httpMatch, d = flattenHTTPMatch(ctx, v.Value)
+		diags.Append(d...)
+	}
+
+	obj := map[string]attr.Value{
+		"http_match": httpMatch,
+	}
+	objVal, d := types.ObjectValue(ruleMatchAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenHTTPMatch(ctx context.Context, apiObject awstypes.HttpMatch) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: httpMatchAttrTypes}
+
+	headerMatches, d := flattenHeaderMatches(ctx, apiObject.HeaderMatches)
+	diags.Append(d...)
+
+	pathMatch, d := flattenPathMatch(ctx, apiObject.PathMatch)
+	diags.Append(d...)
+
+	obj := map[string]attr.Value{
+		"header_matches": headerMatches,
+		"method":         flex.StringToFramework(ctx, apiObject.Method),
+		"path_match":     pathMatch,
+	}
+	objVal, d := types.ObjectValue(httpMatchAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenHeaderMatches(ctx context.Context, apiObjects []awstypes.HeaderMatch) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: headerMatchAttrTypes}
+
+	elems := []attr.Value{}
+	for _, apiObject := range apiObjects {
+		match, d := flattenHeaderMatchType(apiObject.Match)
+		diags.Append(d...)
+
+		obj := map[string]attr.Value{
+			"case_sensitive": types.BoolValue(aws.ToBool(apiObject.CaseSensitive)),
+			"match":          match,
+			"name":           flex.StringToFramework(ctx, apiObject.Name),
+		}
+		objVal, d := types.ObjectValue(headerMatchAttrTypes, obj)
+		diags.Append(d...)
+
+		elems = append(elems, objVal)
+	}
+	listVal, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenHeaderMatchType(apiObject awstypes.HeaderMatchType) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: headerMatchTypeAttrTypes}
+
+	obj := map[string]attr.Value{
+		"contains": types.StringNull(),
+		"exact":    types.StringNull(),
+		"prefix":   types.StringNull(),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.HeaderMatchTypeMemberContains:
+		obj["contains"] = types.StringValue(v.Value)
+	case *awstypes.HeaderMatchTypeMemberExact:
+		obj["exact"] = types.StringValue(v.Value)
+	case *awstypes.HeaderMatchTypeMemberPrefix:
+		obj["prefix"] = types.StringValue(v.Value)
+	}
+
+	objVal, d := types.ObjectValue(headerMatchTypeAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenPathMatch(ctx context.Context, apiObject *awstypes.PathMatch) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: pathMatchAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	match, d := flattenPathMatchType(apiObject.Match)
+	diags.Append(d...)
+
+	obj := map[string]attr.Value{
+		"case_sensitive": types.BoolValue(aws.ToBool(apiObject.CaseSensitive)),
+		"match":          match,
+	}
+	objVal, d := types.ObjectValue(pathMatchAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenPathMatchType(apiObject awstypes.PathMatchType) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: pathMatchTypeAttrTypes}
+
+	obj := map[string]attr.Value{
+		"exact":  types.StringNull(),
+		"prefix": types.StringNull(),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PathMatchTypeMemberExact:
+		obj["exact"] = types.StringValue(v.Value)
+	case *awstypes.PathMatchTypeMemberPrefix:
+		obj["prefix"] = types.StringValue(v.Value)
+	}
+
+	objVal, d := types.ObjectValue(pathMatchTypeAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -0,0 +1,372 @@
+package vpclattice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceService)
+}
+
+func newResourceService(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceService{}
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type resourceService struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceService) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_service"
+}
+
+func (r *resourceService) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.AuthType](),
+				},
+			},
+			"certificate_arn": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fwvalidators.ARN(),
+				},
+			},
+			"custom_domain_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 255),
+				},
+			},
+			"dns_entry": schema.ListAttribute{
+				ElementType: types.ObjectType{AttrTypes: dnsEntryAttrTypes},
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 40),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	ResNameService = "Service"
+)
+
+func (r *resourceService) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceServiceData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	name := data.Name.ValueString()
+	input := &vpclattice.CreateServiceInput{
+		AuthType:         awstypes.AuthType(data.AuthType.ValueString()),
+		CertificateArn:   flex.StringFromFramework(ctx, data.CertificateARN),
+		ClientToken:      aws.String(sdkresource.UniqueId()),
+		CustomDomainName: flex.StringFromFramework(ctx, data.CustomDomainName),
+		Name:             aws.String(name),
+	}
+
+	tags := r.ExpandTags(ctx, data.Tags)
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateService(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameService, name, nil), err.Error())
+
+		return
+	}
+
+	data.ID = flex.StringToFramework(ctx, output.Id)
+
+	service, err := waitServiceCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameService, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	dnsEntry, diags := flattenDNSEntry(ctx, service.DnsEntry)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, service.Arn)
+	data.AuthType = flex.StringValueToFramework(ctx, service.AuthType)
+	data.DNSEntry = dnsEntry
+	data.Status = flex.StringValueToFramework(ctx, service.Status)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceService) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceServiceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	output, err := FindServiceByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameService, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	dnsEntry, diags := flattenDNSEntry(ctx, output.DnsEntry)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.AuthType = flex.StringValueToFramework(ctx, output.AuthType)
+	data.CertificateARN = flex.StringToFramework(ctx, output.CertificateArn)
+	data.CustomDomainName = flex.StringToFramework(ctx, output.CustomDomainName)
+	data.DNSEntry = dnsEntry
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.Status = flex.StringValueToFramework(ctx, output.Status)
+
+	tags, err := ListTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameService, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Tags = r.FlattenTags(ctx, tags)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceService) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceServiceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	if !new.AuthType.Equal(old.AuthType) || !new.CertificateARN.Equal(old.CertificateARN) {
+		input := &vpclattice.UpdateServiceInput{
+			ServiceIdentifier: flex.StringFromFramework(ctx, new.ID),
+		}
+
+		if !new.AuthType.Equal(old.AuthType) {
+			input.AuthType = awstypes.AuthType(new.AuthType.ValueString())
+		}
+
+		if !new.CertificateARN.Equal(old.CertificateARN) {
+			input.CertificateArn = aws.String(new.CertificateARN.ValueString())
+		}
+
+		_, err := conn.UpdateService(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameService, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTags(ctx, conn, new.ARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameService, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceService) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceServiceData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	tflog.Debug(ctx, "deleting VPC Lattice Service", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteService(ctx, &vpclattice.DeleteServiceInput{
+		ServiceIdentifier: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameService, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	if _, err := waitServiceDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameService, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceService) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceService) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+var dnsEntryAttrTypes = map[string]attr.Type{
+	"domain_name":    types.StringType,
+	"hosted_zone_id": types.StringType,
+}
+
+type resourceServiceData struct {
+	ARN              types.String   `tfsdk:"arn"`
+	AuthType         types.String   `tfsdk:"auth_type"`
+	CertificateARN   types.String   `tfsdk:"certificate_arn"`
+	CustomDomainName types.String   `tfsdk:"custom_domain_name"`
+	DNSEntry         types.List     `tfsdk:"dns_entry"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Status           types.String   `tfsdk:"status"`
+	Tags             types.Map      `tfsdk:"tags"`
+	TagsAll          types.Map      `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func flattenDNSEntry(ctx context.Context, apiObject *awstypes.DnsEntry) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: dnsEntryAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	obj := map[string]attr.Value{
+		"domain_name":    flex.StringToFramework(ctx, apiObject.DomainName),
+		"hosted_zone_id": flex.StringToFramework(ctx, apiObject.HostedZoneId),
+	}
+	objVal, d := types.ObjectValue(dnsEntryAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
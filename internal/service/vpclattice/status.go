@@ -0,0 +1,89 @@
+package vpclattice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusService(ctx context.Context, conn *vpclattice.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusTargetGroup(ctx context.Context, conn *vpclattice.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTargetGroupByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusTarget(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTargetByThreePartKey(ctx, conn, targetGroupID, targetID, targetPort)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusServiceNetworkVPCAssociation(ctx context.Context, conn *vpclattice.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceNetworkVPCAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusServiceNetworkServiceAssociation(ctx context.Context, conn *vpclattice.Client, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceNetworkServiceAssociationByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
@@ -0,0 +1,280 @@
+//go:build sweep
+// +build sweep
+
+package vpclattice
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_vpclattice_service", &resource.Sweeper{
+		Name: "aws_vpclattice_service",
+		F:    sweepServices,
+		Dependencies: []string{
+			"aws_vpclattice_service_network_service_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_vpclattice_service_network", &resource.Sweeper{
+		Name: "aws_vpclattice_service_network",
+		F:    sweepServiceNetworks,
+		Dependencies: []string{
+			"aws_vpclattice_service_network_service_association",
+			"aws_vpclattice_service_network_vpc_association",
+		},
+	})
+
+	resource.AddTestSweepers("aws_vpclattice_service_network_service_association", &resource.Sweeper{
+		Name: "aws_vpclattice_service_network_service_association",
+		F:    sweepServiceNetworkServiceAssociations,
+	})
+
+	resource.AddTestSweepers("aws_vpclattice_service_network_vpc_association", &resource.Sweeper{
+		Name: "aws_vpclattice_service_network_vpc_association",
+		F:    sweepServiceNetworkVPCAssociations,
+	})
+
+	resource.AddTestSweepers("aws_vpclattice_target_group", &resource.Sweeper{
+		Name: "aws_vpclattice_target_group",
+		F:    sweepTargetGroups,
+		Dependencies: []string{
+			"aws_vpclattice_service",
+		},
+	})
+}
+
+func sweepServices(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).VPCLatticeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := vpclattice.NewListServicesPaginator(conn, &vpclattice.ListServicesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping VPC Lattice Service sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Services (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceService, aws.ToString(v.Id), client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping VPC Lattice Services (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepServiceNetworks(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).VPCLatticeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := vpclattice.NewListServiceNetworksPaginator(conn, &vpclattice.ListServiceNetworksInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping VPC Lattice Service Network sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Service Networks (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceServiceNetwork, aws.ToString(v.Id), client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping VPC Lattice Service Networks (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepServiceNetworkServiceAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).VPCLatticeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := vpclattice.NewListServiceNetworksPaginator(conn, &vpclattice.ListServiceNetworksInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping VPC Lattice Service Network Service Association sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Service Networks (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Items {
+			input := &vpclattice.ListServiceNetworkServiceAssociationsInput{
+				ServiceNetworkIdentifier: v.Id,
+			}
+			paginator := vpclattice.NewListServiceNetworkServiceAssociationsPaginator(conn, input)
+
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Service Network (%s) Service Associations (%s): %w", aws.ToString(v.Id), region, err))
+					break
+				}
+
+				for _, v := range page.Items {
+					sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceServiceNetworkServiceAssociation, aws.ToString(v.Id), client))
+				}
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping VPC Lattice Service Network Service Associations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepServiceNetworkVPCAssociations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).VPCLatticeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := vpclattice.NewListServiceNetworksPaginator(conn, &vpclattice.ListServiceNetworksInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping VPC Lattice Service Network VPC Association sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Service Networks (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Items {
+			input := &vpclattice.ListServiceNetworkVpcAssociationsInput{
+				ServiceNetworkIdentifier: v.Id,
+			}
+			paginator := vpclattice.NewListServiceNetworkVpcAssociationsPaginator(conn, input)
+
+			for paginator.HasMorePages() {
+				page, err := paginator.NextPage(ctx)
+
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Service Network (%s) VPC Associations (%s): %w", aws.ToString(v.Id), region, err))
+					break
+				}
+
+				for _, v := range page.Items {
+					sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceServiceNetworkVPCAssociation, aws.ToString(v.Id), client))
+				}
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping VPC Lattice Service Network VPC Associations (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepTargetGroups(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).VPCLatticeClient()
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	paginator := vpclattice.NewListTargetGroupsPaginator(conn, &vpclattice.ListTargetGroupsInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping VPC Lattice Target Group sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("listing VPC Lattice Target Groups (%s): %w", region, err))
+			break
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceTargetGroup, aws.ToString(v.Id), client))
+		}
+	}
+
+	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping VPC Lattice Target Groups (%s): %w", region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,641 @@
+package vpclattice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwboolplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/boolplanmodifier"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceTargetGroup)
+}
+
+func newResourceTargetGroup(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTargetGroup{}
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type resourceTargetGroup struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceTargetGroup) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_target_group"
+}
+
+func (r *resourceTargetGroup) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	computedInt64Attribute := func(validators ...validator.Int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Validators: validators,
+		}
+	}
+	computedStringAttribute := func(validators ...validator.String) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: validators,
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": framework.ARNAttributeComputedOnly(),
+			"id":  framework.IDAttribute(),
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 128),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":     tftags.TagsAttribute(),
+			"tags_all": tftags.TagsAttributeComputedOnly(),
+			"type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.TargetGroupType](),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"config": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address_type": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.IpAddressType](),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Required: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"protocol": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.TargetGroupProtocol](),
+							},
+						},
+						"protocol_version": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.TargetGroupProtocolVersion](),
+							},
+						},
+						"vpc_identifier": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"health_check": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"enabled": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Bool{
+											fwboolplanmodifier.DefaultValue(true),
+										},
+									},
+									"health_check_interval_seconds": computedInt64Attribute(int64validator.Between(5, 300)),
+									"health_check_timeout_seconds":  computedInt64Attribute(int64validator.Between(1, 120)),
+									"healthy_threshold_count":       computedInt64Attribute(int64validator.Between(2, 10)),
+									"path":                          computedStringAttribute(),
+									"port":                          computedInt64Attribute(int64validator.Between(1, 65535)),
+									"protocol":                      computedStringAttribute(enum.FrameworkValidate[awstypes.TargetGroupProtocol]()),
+									"protocol_version":              computedStringAttribute(enum.FrameworkValidate[awstypes.HealthCheckProtocolVersion]()),
+									"unhealthy_threshold_count":     computedInt64Attribute(int64validator.Between(2, 10)),
+								},
+								Blocks: map[string]schema.Block{
+									"matcher": schema.ListNestedBlock{
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"value": computedStringAttribute(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	ResNameTargetGroup = "Target Group"
+)
+
+func (r *resourceTargetGroup) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTargetGroupData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	config, diags := expandTargetGroupConfig(ctx, data.Config)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &vpclattice.CreateTargetGroupInput{
+		ClientToken: aws.String(sdkresource.UniqueId()),
+		Config:      config,
+		Name:        aws.String(name),
+		Type:        awstypes.TargetGroupType(data.Type.ValueString()),
+	}
+
+	tags := r.ExpandTags(ctx, data.Tags)
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	output, err := conn.CreateTargetGroup(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroup, name, nil), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.Id)
+	targetGroup, err := waitTargetGroupCreated(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameTargetGroup, id, nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Config, diags = flattenTargetGroupConfig(ctx, targetGroup.Config, data.Config)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, targetGroup.Arn)
+	data.ID = types.StringValue(id)
+	data.Status = flex.StringValueToFramework(ctx, targetGroup.Status)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTargetGroup) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTargetGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	output, err := FindTargetGroupByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameTargetGroup, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	config, diags := flattenTargetGroupConfig(ctx, output.Config, data.Config)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.Config = config
+	data.Name = flex.StringToFramework(ctx, output.Name)
+	data.Status = flex.StringValueToFramework(ctx, output.Status)
+	data.Type = flex.StringValueToFramework(ctx, output.Type)
+
+	tags, err := ListTags(ctx, conn, aws.ToString(output.Arn))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameTargetGroup, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Tags = r.FlattenTags(ctx, tags)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTargetGroup) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceTargetGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	if !new.Config.Equal(old.Config) {
+		config, diags := expandTargetGroupConfig(ctx, new.Config)
+		response.Diagnostics.Append(diags...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if config != nil && config.HealthCheck != nil {
+			input := &vpclattice.UpdateTargetGroupInput{
+				HealthCheck:           config.HealthCheck,
+				TargetGroupIdentifier: flex.StringFromFramework(ctx, new.ID),
+			}
+
+			output, err := conn.UpdateTargetGroup(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameTargetGroup, new.ID.ValueString(), nil), err.Error())
+
+				return
+			}
+
+			// Health check values not in configuration are returned by the API.
+			new.Config, diags = flattenTargetGroupConfig(ctx, output.Config, new.Config)
+			response.Diagnostics.Append(diags...)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if !new.TagsAll.Equal(old.TagsAll) {
+		if err := UpdateTags(ctx, conn, new.ARN.ValueString(), old.TagsAll, new.TagsAll); err != nil {
+			response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionUpdating, ResNameTargetGroup, new.ID.ValueString(), nil), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTargetGroup) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTargetGroupData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	tflog.Debug(ctx, "deleting VPC Lattice Target Group", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err := conn.DeleteTargetGroup(ctx, &vpclattice.DeleteTargetGroupInput{
+		TargetGroupIdentifier: flex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroup, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	if _, err := waitTargetGroupDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameTargetGroup, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceTargetGroup) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceTargetGroup) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceTargetGroupData struct {
+	ARN      types.String   `tfsdk:"arn"`
+	Config   types.List     `tfsdk:"config"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Status   types.String   `tfsdk:"status"`
+	Tags     types.Map      `tfsdk:"tags"`
+	TagsAll  types.Map      `tfsdk:"tags_all"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Type     types.String   `tfsdk:"type"`
+}
+
+var (
+	targetGroupConfigAttrTypes = map[string]attr.Type{
+		"health_check":     types.ListType{ElemType: types.ObjectType{AttrTypes: healthCheckConfigAttrTypes}},
+		"ip_address_type":  types.StringType,
+		"port":             types.Int64Type,
+		"protocol":         types.StringType,
+		"protocol_version": types.StringType,
+		"vpc_identifier":   types.StringType,
+	}
+
+	healthCheckConfigAttrTypes = map[string]attr.Type{
+		"enabled":                       types.BoolType,
+		"health_check_interval_seconds": types.Int64Type,
+		"health_check_timeout_seconds":  types.Int64Type,
+		"healthy_threshold_count":       types.Int64Type,
+		"matcher":                       types.ListType{ElemType: types.ObjectType{AttrTypes: matcherAttrTypes}},
+		"path":                          types.StringType,
+		"port":                          types.Int64Type,
+		"protocol":                      types.StringType,
+		"protocol_version":              types.StringType,
+		"unhealthy_threshold_count":     types.Int64Type,
+	}
+
+	matcherAttrTypes = map[string]attr.Type{
+		"value": types.StringType,
+	}
+)
+
+type targetGroupConfigData struct {
+	HealthCheck     types.List   `tfsdk:"health_check"`
+	IPAddressType   types.String `tfsdk:"ip_address_type"`
+	Port            types.Int64  `tfsdk:"port"`
+	Protocol        types.String `tfsdk:"protocol"`
+	ProtocolVersion types.String `tfsdk:"protocol_version"`
+	VPCIdentifier   types.String `tfsdk:"vpc_identifier"`
+}
+
+type healthCheckConfigData struct {
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	HealthCheckIntervalSeconds types.Int64  `tfsdk:"health_check_interval_seconds"`
+	HealthCheckTimeoutSeconds  types.Int64  `tfsdk:"health_check_timeout_seconds"`
+	HealthyThresholdCount      types.Int64  `tfsdk:"healthy_threshold_count"`
+	Matcher                    types.List   `tfsdk:"matcher"`
+	Path                       types.String `tfsdk:"path"`
+	Port                       types.Int64  `tfsdk:"port"`
+	Protocol                   types.String `tfsdk:"protocol"`
+	ProtocolVersion            types.String `tfsdk:"protocol_version"`
+	UnhealthyThresholdCount    types.Int64  `tfsdk:"unhealthy_threshold_count"`
+}
+
+type matcherData struct {
+	Value types.String `tfsdk:"value"`
+}
+
+func expandTargetGroupConfig(ctx context.Context, tfList types.List) (*awstypes.TargetGroupConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []targetGroupConfigData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return nil, diags
+	}
+
+	tfObject := data[0]
+	apiObject := &awstypes.TargetGroupConfig{
+		IpAddressType:   awstypes.IpAddressType(tfObject.IPAddressType.ValueString()),
+		Port:            flex.Int32FromFramework(ctx, tfObject.Port),
+		Protocol:        awstypes.TargetGroupProtocol(tfObject.Protocol.ValueString()),
+		ProtocolVersion: awstypes.TargetGroupProtocolVersion(tfObject.ProtocolVersion.ValueString()),
+		VpcIdentifier:   flex.StringFromFramework(ctx, tfObject.VPCIdentifier),
+	}
+
+	var healthCheck []healthCheckConfigData
+	diags.Append(tfObject.HealthCheck.ElementsAs(ctx, &healthCheck, false)...)
+
+	if len(healthCheck) > 0 {
+		var d diag.Diagnostics
+		apiObject.HealthCheck, d = expandHealthCheckConfig(ctx, healthCheck[0])
+		diags.Append(d...)
+	}
+
+	return apiObject, diags
+}
+
+func expandHealthCheckConfig(ctx context.Context, tfObject healthCheckConfigData) (*awstypes.HealthCheckConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	apiObject := &awstypes.HealthCheckConfig{
+		Enabled:                    flex.BoolFromFramework(ctx, tfObject.Enabled),
+		HealthCheckIntervalSeconds: flex.Int32FromFramework(ctx, tfObject.HealthCheckIntervalSeconds),
+		HealthCheckTimeoutSeconds:  flex.Int32FromFramework(ctx, tfObject.HealthCheckTimeoutSeconds),
+		HealthyThresholdCount:      flex.Int32FromFramework(ctx, tfObject.HealthyThresholdCount),
+		Path:                       flex.StringFromFramework(ctx, tfObject.Path),
+		Port:                       flex.Int32FromFramework(ctx, tfObject.Port),
+		Protocol:                   awstypes.TargetGroupProtocol(tfObject.Protocol.ValueString()),
+		ProtocolVersion:            awstypes.HealthCheckProtocolVersion(tfObject.ProtocolVersion.ValueString()),
+		UnhealthyThresholdCount:    flex.Int32FromFramework(ctx, tfObject.UnhealthyThresholdCount),
+	}
+
+	var matcher []matcherData
+	diags.Append(tfObject.Matcher.ElementsAs(ctx, &matcher, false)...)
+
+	if len(matcher) > 0 {
+		if v := matcher[0].Value.ValueString(); v != "" {
+			apiObject.Matcher = &awstypes.MatcherMemberHttpCode{Value: v}
+		}
+	}
+
+	return apiObject, diags
+}
+
+// flattenTargetGroupConfig flattens the API target group configuration.
+// The optional health_check and matcher blocks are only set if present in the prior value,
+// as the API returns defaults for them even when they are not configured.
+func flattenTargetGroupConfig(ctx context.Context, apiObject *awstypes.TargetGroupConfig, prior types.List) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: targetGroupConfigAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	var priorConfig []targetGroupConfigData
+	diags.Append(prior.ElementsAs(ctx, &priorConfig, false)...)
+
+	var priorHealthCheck []healthCheckConfigData
+	if len(priorConfig) > 0 {
+		diags.Append(priorConfig[0].HealthCheck.ElementsAs(ctx, &priorHealthCheck, false)...)
+	}
+
+	healthCheck := types.ListValueMust(types.ObjectType{AttrTypes: healthCheckConfigAttrTypes}, []attr.Value{})
+
+	if len(priorHealthCheck) > 0 && apiObject.HealthCheck != nil {
+		var d diag.Diagnostics
+		healthCheck, d = flattenHealthCheckConfig(ctx, apiObject.HealthCheck, len(priorHealthCheck[0].Matcher.Elements()) > 0)
+		diags.Append(d...)
+	}
+
+	obj := map[string]attr.Value{
+		"health_check":     healthCheck,
+		"ip_address_type":  flex.StringValueToFramework(ctx, apiObject.IpAddressType),
+		"port":             flex.Int32ToFramework(ctx, apiObject.Port),
+		"protocol":         flex.StringValueToFramework(ctx, apiObject.Protocol),
+		"protocol_version": flex.StringValueToFramework(ctx, apiObject.ProtocolVersion),
+		"vpc_identifier":   flex.StringToFramework(ctx, apiObject.VpcIdentifier),
+	}
+	objVal, d := types.ObjectValue(targetGroupConfigAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
+
+func flattenHealthCheckConfig(ctx context.Context, apiObject *awstypes.HealthCheckConfig, includeMatcher bool) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: healthCheckConfigAttrTypes}
+
+	matcher := types.ListValueMust(types.ObjectType{AttrTypes: matcherAttrTypes}, []attr.Value{})
+
+	if v, ok := apiObject.Matcher.(*awstypes.MatcherMemberHttpCode); ok && includeMatcher {
+		objVal, d := types.ObjectValue(matcherAttrTypes, map[string]attr.Value{
+			"value": types.StringValue(v.Value),
+		})
+		diags.Append(d...)
+
+		matcher, d = types.ListValue(types.ObjectType{AttrTypes: matcherAttrTypes}, []attr.Value{objVal})
+		diags.Append(d...)
+	}
+
+	obj := map[string]attr.Value{
+		"enabled":                       types.BoolValue(aws.ToBool(apiObject.Enabled)),
+		"health_check_interval_seconds": flex.Int32ToFramework(ctx, apiObject.HealthCheckIntervalSeconds),
+		"health_check_timeout_seconds":  flex.Int32ToFramework(ctx, apiObject.HealthCheckTimeoutSeconds),
+		"healthy_threshold_count":       flex.Int32ToFramework(ctx, apiObject.HealthyThresholdCount),
+		"matcher":                       matcher,
+		"path":                          flex.StringToFramework(ctx, apiObject.Path),
+		"port":                          flex.Int32ToFramework(ctx, apiObject.Port),
+		"protocol":                      flex.StringValueToFramework(ctx, apiObject.Protocol),
+		"protocol_version":              flex.StringValueToFramework(ctx, apiObject.ProtocolVersion),
+		"unhealthy_threshold_count":     flex.Int32ToFramework(ctx, apiObject.UnhealthyThresholdCount),
+	}
+	objVal, d := types.ObjectValue(healthCheckConfigAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
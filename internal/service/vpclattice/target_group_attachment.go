@@ -0,0 +1,340 @@
+package vpclattice
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func init() {
+	_sp.registerFrameworkResourceFactory(newResourceTargetGroupAttachment)
+}
+
+func newResourceTargetGroupAttachment(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTargetGroupAttachment{}
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type resourceTargetGroupAttachment struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceTargetGroupAttachment) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpclattice_target_group_attachment"
+}
+
+func (r *resourceTargetGroupAttachment) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": framework.IDAttribute(),
+			"target_group_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"target": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+								int64planmodifier.RequiresReplace(),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+const (
+	ResNameTargetGroupAttachment = "Target Group Attachment"
+
+	targetGroupAttachmentIDPartCount = 3
+)
+
+func (r *resourceTargetGroupAttachment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTargetGroupAttachmentData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	target, diags := expandTarget(ctx, data.Target)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	targetGroupID := data.TargetGroupIdentifier.ValueString()
+	targetID := aws.ToString(target.Id)
+	targetPort := int(aws.ToInt32(target.Port))
+	id, err := flex.FlattenResourceId([]string{targetGroupID, targetID, strconv.Itoa(targetPort)}, targetGroupAttachmentIDPartCount)
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionFlatteningResourceId, ResNameTargetGroupAttachment, targetID, nil), err.Error())
+
+		return
+	}
+
+	input := &vpclattice.RegisterTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupID),
+		Targets:               []awstypes.Target{target},
+	}
+
+	output, err := conn.RegisterTargets(ctx, input)
+
+	if err == nil && output != nil && len(output.Unsuccessful) > 0 {
+		err = failureError(output.Unsuccessful[0].FailureCode, output.Unsuccessful[0].FailureMessage)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionCreating, ResNameTargetGroupAttachment, id, nil), err.Error())
+
+		return
+	}
+
+	targetSummary, err := waitTargetRegistered(ctx, conn, targetGroupID, targetID, targetPort, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionWaitingForCreation, ResNameTargetGroupAttachment, id, nil), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+	data.Target, diags = flattenTargetSummary(ctx, targetSummary)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTargetGroupAttachment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTargetGroupAttachmentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	target, diags := expandTarget(ctx, data.Target)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	output, err := FindTargetByThreePartKey(ctx, conn, data.TargetGroupIdentifier.ValueString(), aws.ToString(target.Id), int(aws.ToInt32(target.Port)))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionReading, ResNameTargetGroupAttachment, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	data.Target, diags = flattenTargetSummary(ctx, output)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTargetGroupAttachment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceTargetGroupAttachmentData
+
+	// All attributes other than timeouts force replacement.
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTargetGroupAttachment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTargetGroupAttachmentData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), targetGroupAttachmentIDPartCount)
+
+	if err == nil {
+		_, err = strconv.Atoi(parts[2])
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionExpandingResourceId, ResNameTargetGroupAttachment, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	conn := r.Meta().VPCLatticeClient()
+
+	targetGroupID, targetID := parts[0], parts[1]
+	targetPort, _ := strconv.Atoi(parts[2])
+	target := awstypes.Target{
+		Id: aws.String(targetID),
+	}
+
+	if targetPort != 0 {
+		target.Port = aws.Int32(int32(targetPort))
+	}
+
+	tflog.Debug(ctx, "deleting VPC Lattice Target Group Attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+	_, err = conn.DeregisterTargets(ctx, &vpclattice.DeregisterTargetsInput{
+		TargetGroupIdentifier: aws.String(targetGroupID),
+		Targets:               []awstypes.Target{target},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroupAttachment, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+
+	if _, err := waitTargetDeregistered(ctx, conn, targetGroupID, targetID, targetPort, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(create.ProblemStandardMessage(names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameTargetGroupAttachment, data.ID.ValueString(), nil), err.Error())
+
+		return
+	}
+}
+
+type resourceTargetGroupAttachmentData struct {
+	ID                    types.String   `tfsdk:"id"`
+	Target                types.List     `tfsdk:"target"`
+	TargetGroupIdentifier types.String   `tfsdk:"target_group_identifier"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+var targetAttrTypes = map[string]attr.Type{
+	"id":   types.StringType,
+	"port": types.Int64Type,
+}
+
+type targetData struct {
+	ID   types.String `tfsdk:"id"`
+	Port types.Int64  `tfsdk:"port"`
+}
+
+func expandTarget(ctx context.Context, tfList types.List) (awstypes.Target, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var data []targetData
+
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+
+	if diags.HasError() || len(data) == 0 {
+		return awstypes.Target{}, diags
+	}
+
+	return awstypes.Target{
+		Id:   flex.StringFromFramework(ctx, data[0].ID),
+		Port: flex.Int32FromFramework(ctx, data[0].Port),
+	}, diags
+}
+
+func flattenTargetSummary(ctx context.Context, apiObject *awstypes.TargetSummary) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: targetAttrTypes}
+
+	if apiObject == nil {
+		return types.ListValueMust(elemType, []attr.Value{}), diags
+	}
+
+	obj := map[string]attr.Value{
+		"id":   flex.StringToFramework(ctx, apiObject.Id),
+		"port": flex.Int32ToFramework(ctx, apiObject.Port),
+	}
+	objVal, d := types.ObjectValue(targetAttrTypes, obj)
+	diags.Append(d...)
+
+	listVal, d := types.ListValue(elemType, []attr.Value{objVal})
+	diags.Append(d...)
+
+	return listVal, diags
+}
@@ -0,0 +1,248 @@
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitServiceCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceStatusCreateInProgress),
+		Target:                    enum.Slice(types.ServiceStatusActive),
+		Refresh:                   statusService(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.ServiceStatusDeleteInProgress, types.ServiceStatusActive),
+		Target:  []string{},
+		Refresh: statusService(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.TargetGroupStatusCreateInProgress),
+		Target:                    enum.Slice(types.TargetGroupStatusActive),
+		Refresh:                   statusTargetGroup(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTargetGroupDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetTargetGroupOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.TargetGroupStatusDeleteInProgress, types.TargetGroupStatusActive),
+		Target:  []string{},
+		Refresh: statusTargetGroup(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetTargetGroupOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTargetRegistered(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int, timeout time.Duration) (*types.TargetSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.TargetStatusInitial),
+		Target:                    enum.Slice(types.TargetStatusHealthy, types.TargetStatusUnhealthy, types.TargetStatusUnused, types.TargetStatusUnavailable),
+		Refresh:                   statusTarget(ctx, conn, targetGroupID, targetID, targetPort),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.TargetSummary); ok {
+		if v := aws.ToString(output.ReasonCode); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTargetDeregistered(ctx context.Context, conn *vpclattice.Client, targetGroupID, targetID string, targetPort int, timeout time.Duration) (*types.TargetSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.TargetStatusDraining, types.TargetStatusInitial),
+		Target:  []string{},
+		Refresh: statusTarget(ctx, conn, targetGroupID, targetID, targetPort),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.TargetSummary); ok {
+		if v := aws.ToString(output.ReasonCode); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusCreateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
+		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationUpdated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusUpdateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
+		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
+		Target:  []string{},
+		Refresh: statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkVpcAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkServiceAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkServiceAssociationStatusCreateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkServiceAssociationStatusActive),
+		Refresh:                   statusServiceNetworkServiceAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkServiceAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkServiceAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkServiceAssociationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: enum.Slice(types.ServiceNetworkServiceAssociationStatusDeleteInProgress, types.ServiceNetworkServiceAssociationStatusActive),
+		Target:  []string{},
+		Refresh: statusServiceNetworkServiceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*vpclattice.GetServiceNetworkServiceAssociationOutput); ok {
+		tfresource.SetLastError(err, failureError(output.FailureCode, output.FailureMessage))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func failureError(code, message *string) error {
+	if code == nil && message == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.ToString(code), aws.ToString(message))
+}
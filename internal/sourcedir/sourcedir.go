@@ -0,0 +1,156 @@
+// Package sourcedir walks local source directories whose contents are
+// packaged or uploaded by resources, such as aws_lambda_function source_dir
+// and aws_s3_directory_sync.
+package sourcedir
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// File is a regular file in a source directory.
+type File struct {
+	// Slash-separated path of the file relative to the source directory.
+	Name string
+	// Path of the file on the local file system.
+	Path string
+	// Mode of the file, following symbolic links.
+	Mode fs.FileMode
+}
+
+// Walk returns the regular files in dir, in lexical order of their slash-separated path relative to dir.
+// Symbolic links to files are followed. Symbolic links to directories and other file types are rejected.
+// Files and directories whose relative path, or base name, matches any of the excludes glob patterns are skipped.
+func Walk(dir string, excludes []string) ([]File, error) {
+	dir, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var files []File
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, p)
+
+		if err != nil {
+			return err
+		}
+
+		if rel == "." {
+			return nil
+		}
+
+		name := filepath.ToSlash(rel)
+
+		excluded, err := MatchAny(name, excludes)
+
+		if err != nil {
+			return err
+		}
+
+		if excluded {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Stat follows symbolic links so that linked files are read with the target's content.
+		fi, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if fi.IsDir() {
+			return fmt.Errorf("%s: symbolic links to directories are not supported", name)
+		}
+
+		if !fi.Mode().IsRegular() {
+			return fmt.Errorf("%s: unsupported file type (%s)", name, fi.Mode().Type())
+		}
+
+		files = append(files, File{
+			Name: name,
+			Path: p,
+			Mode: fi.Mode(),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	return files, nil
+}
+
+// Match returns whether the slash-separated relative path name, or its base name, matches the glob pattern.
+func Match(name, pattern string) (bool, error) {
+	for _, v := range []string{name, path.Base(name)} {
+		matched, err := path.Match(pattern, v)
+
+		if err != nil {
+			return false, fmt.Errorf("pattern (%s): %w", pattern, err)
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// MatchAny returns whether the slash-separated relative path name, or its base name, matches any of the glob patterns.
+func MatchAny(name string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := Match(name, pattern)
+
+		if err != nil {
+			return false, err
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// ValidPattern validates that a string is a glob pattern accepted by Match.
+func ValidPattern(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid glob pattern: %s", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,130 @@
+package sourcedir
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.js":             "exports.handler = async () => {}",
+		"lib/util.js":          "",
+		"lib/util_test.js":     "",
+		"README.md":            "# example",
+		"node_modules/x/x.js":  "x",
+		"tests/handler.js":     "",
+		"tests/data/input.txt": "",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := Walk(dir, []string{"*.md", "tests", "node_modules", "lib/*_test.js"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+
+	for _, file := range files {
+		names = append(names, file.Name)
+
+		if got, expected := file.Path, filepath.Join(dir, filepath.FromSlash(file.Name)); got != expected {
+			t.Errorf("%s: got path %s, expected %s", file.Name, got, expected)
+		}
+	}
+
+	if got, expected := strings.Join(names, ","), "index.js,lib/util.js"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}
+
+func TestWalk_symlinkToDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(dir, "target"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(filepath.Join(dir, "target"), filepath.Join(dir, "link")); err != nil {
+		t.Skipf("creating symbolic link: %s", err)
+	}
+
+	if _, err := Walk(dir, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		pattern  string
+		expected bool
+	}{
+		{"index.html", "*.html", true},
+		{"css/site.css", "*.css", true},
+		{"css/site.css", "css/*", true},
+		{"css/site.css", "*.html", false},
+		{"js/app.js.map", "*.map", true},
+		{"js/vendor/lib.js", "js/*", false},
+	}
+
+	for _, testCase := range testCases {
+		got, err := Match(testCase.name, testCase.pattern)
+
+		if err != nil {
+			t.Fatalf("%s %s: unexpected error: %s", testCase.name, testCase.pattern, err)
+		}
+
+		if got != testCase.expected {
+			t.Errorf("%s %s: got %t, expected %t", testCase.name, testCase.pattern, got, testCase.expected)
+		}
+	}
+}
+
+func TestValidPattern(t *testing.T) {
+	t.Parallel()
+
+	validPatterns := []string{
+		"*.md",
+		"tests",
+		"src/*_test.py",
+		"[a-c]?.txt",
+	}
+	for _, v := range validPatterns {
+		_, errors := ValidPattern(v, "excludes")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		"[",
+		"src/[a-",
+		"\\",
+	}
+	for _, v := range invalidPatterns {
+		_, errors := ValidPattern(v, "excludes")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid pattern", v)
+		}
+	}
+}
@@ -208,6 +208,7 @@ const (
 	NetworkFirewall              = "networkfirewall"
 	NetworkManager               = "networkmanager"
 	Nimble                       = "nimble"
+	ObservabilityAccessManager   = "oam"
 	OpenSearch                   = "opensearch"
 	OpenSearchServerless         = "opensearchserverless"
 	OpsWorks                     = "opsworks"
@@ -277,6 +278,7 @@ const (
 	Schemas                      = "schemas"
 	SecretsManager               = "secretsmanager"
 	SecurityHub                  = "securityhub"
+	SecurityLake                 = "securitylake"
 	ServerlessRepo               = "serverlessrepo"
 	ServiceCatalog               = "servicecatalog"
 	ServiceCatalogAppRegistry    = "servicecatalogappregistry"
@@ -297,6 +299,7 @@ const (
 	TranscribeStreaming          = "transcribestreaming"
 	Transfer                     = "transfer"
 	Translate                    = "translate"
+	VPCLattice                   = "vpclattice"
 	VoiceID                      = "voiceid"
 	WAF                          = "waf"
 	WAFRegional                  = "wafregional"
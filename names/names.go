@@ -38,9 +38,11 @@ const (
 	RolesAnywhereEndpointID        = "rolesanywhere"
 	Route53DomainsEndpointID       = "route53domains"
 	SchedulerEndpointID            = "scheduler"
+	SecurityLakeEndpointID         = "securitylake"
 	SESV2EndpointID                = "sesv2"
 	SSMEndpointID                  = "ssm"
 	TranscribeEndpointID           = "transcribe"
+	VPCLatticeEndpointID           = "vpc-lattice"
 )
 
 // Type ServiceDatum corresponds closely to columns in `names_data.csv` and are